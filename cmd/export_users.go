@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"user-service/domain/dto"
+	"user-service/repositories"
+	"user-service/services"
+
+	"github.com/spf13/cobra"
+)
+
+var exportUsersCommand = &cobra.Command{
+	Use:   "export-users",
+	Short: "Stream users as CSV or NDJSON",
+	Run: func(c *cobra.Command, args []string) {
+		var request dto.UserExportRequest
+		request.Format, _ = c.Flags().GetString("format")
+		request.Columns, _ = c.Flags().GetString("columns")
+		request.MaskPII, _ = c.Flags().GetBool("mask-pii")
+		request.Role, _ = c.Flags().GetString("role")
+		request.Status, _ = c.Flags().GetString("status")
+		request.CreatedFrom, _ = c.Flags().GetString("created-from")
+		request.CreatedTo, _ = c.Flags().GetString("created-to")
+		request.EmailDomain, _ = c.Flags().GetString("email-domain")
+		output, _ := c.Flags().GetString("output")
+
+		writer := os.Stdout
+		if output != "" {
+			file, err := os.Create(output)
+			if err != nil {
+				panic(err)
+			}
+			defer file.Close()
+			writer = file
+		}
+
+		db := initDatabase()
+		repository := repositories.NewRepositoryRegistry(db)
+		service := services.NewServiceRegistry(repository)
+
+		err := service.GetUserExport().Export(context.Background(), writer, &request)
+		if err != nil {
+			panic(err)
+		}
+	},
+}
+
+func init() {
+	exportUsersCommand.Flags().String("format", "csv", "output format: csv or ndjson")
+	exportUsersCommand.Flags().String("columns", "", "comma separated columns (defaults to all)")
+	exportUsersCommand.Flags().Bool("mask-pii", false, "mask name, email and phone number")
+	exportUsersCommand.Flags().String("role", "", "filter by role code")
+	exportUsersCommand.Flags().String("status", "", "filter by account status")
+	exportUsersCommand.Flags().String("created-from", "", "filter by creation date (YYYY-MM-DD)")
+	exportUsersCommand.Flags().String("created-to", "", "filter by creation date (YYYY-MM-DD)")
+	exportUsersCommand.Flags().String("email-domain", "", "filter by email domain")
+	exportUsersCommand.Flags().StringP("output", "o", "", "output file (defaults to stdout)")
+	command.AddCommand(exportUsersCommand)
+}
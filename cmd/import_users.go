@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"user-service/domain/dto"
+	"user-service/repositories"
+	"user-service/services"
+	userImportServices "user-service/services/userimport"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var importUsersCommand = &cobra.Command{
+	Use:   "import-users",
+	Short: "Import users from a CSV or NDJSON file",
+	Run: func(c *cobra.Command, args []string) {
+		filePath, _ := c.Flags().GetString("file")
+		format, _ := c.Flags().GetString("format")
+		dryRun, _ := c.Flags().GetBool("dry-run")
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			panic(err)
+		}
+		defer file.Close()
+
+		if format == "" {
+			format = userImportServices.FormatFromFilename(filePath)
+		}
+
+		db := initDatabase()
+		repository := repositories.NewRepositoryRegistry(db)
+		service := services.NewServiceRegistry(repository)
+
+		result, err := service.GetUserImport().Import(context.Background(), file, &dto.UserImportRequest{
+			Format: format,
+			DryRun: dryRun,
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(result)
+		if err != nil {
+			panic(err)
+		}
+
+		logrus.Infof("%d of %d users successfuly imported", result.Imported, result.Total)
+		if result.Invalid > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	importUsersCommand.Flags().StringP("file", "f", "", "path to the CSV or NDJSON file")
+	importUsersCommand.Flags().String("format", "", "file format: csv or ndjson (defaults to the file extension)")
+	importUsersCommand.Flags().Bool("dry-run", false, "validate the file without inserting users")
+	_ = importUsersCommand.MarkFlagRequired("file")
+	command.AddCommand(importUsersCommand)
+}
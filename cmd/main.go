@@ -1,15 +1,23 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
+	"user-service/common/breach"
+	"user-service/common/otp"
+	"user-service/common/password"
+	"user-service/common/policy"
 	"user-service/common/response"
+	"user-service/common/storage"
 	"user-service/config"
 	"user-service/constants"
 	"user-service/controllers"
+	"user-service/database/migration"
 	"user-service/database/seeder"
 	"user-service/domain/models"
+	"user-service/jobs"
 	"user-service/middlewares"
 	"user-service/repositories"
 	"user-service/routes"
@@ -20,39 +28,65 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
 	"github.com/spf13/cobra"
+	"gorm.io/gorm"
 )
 
 var command = &cobra.Command{
 	Use:   "serve",
 	Short: "Start the server",
 	Run: func(c *cobra.Command, args []string) {
-		_ = godotenv.Load()
-		config.Init()
-		db, err := config.InitDatabase()
-		if err != nil {
-			panic(err)
-		}
-
-		loc, err := time.LoadLocation("Asia/Jakarta")
-		if err != nil {
-			panic(err)
-		}
-		time.Local = loc
-
-		err = db.AutoMigrate(
+		db := initDatabase()
+		err := db.AutoMigrate(
 			&models.Role{},
 			&models.User{},
+			&models.Policy{},
+			&models.DataExport{},
+			&models.AthleteProfile{},
+			&models.GuardianLink{},
+			&models.GuardianApproval{},
+			&models.EmergencyInfo{},
+			&models.EmergencyAccessGrant{},
+			&models.EmergencyAccessLog{},
+			&models.UserPreference{},
+			&models.AttributeDefinition{},
+			&models.UserAttributeValue{},
+			&models.Membership{},
+			&models.ConsentDocument{},
+			&models.ConsentAcceptance{},
+			&models.Suspension{},
+			&models.UsernameHistory{},
+			&models.PhoneVerification{},
 		)
 		if err != nil {
 			panic(err)
 		}
 
+		password.Init(passwordHasher())
+		migration.NewMigrationRegistry(db).Run()
 		seeder.NewSeederRegistry(db).Run()
 		repository := repositories.NewRepositoryRegistry(db)
+		engine, err := policy.Init(context.Background(), policyLoader(repository))
+		if err != nil {
+			panic(err)
+		}
+		go engine.Watch(context.Background(), time.Duration(config.Config.Policy.ReloadIntervalSecond)*time.Second)
+
 		service := services.NewServiceRegistry(repository)
+		storage.Init(storageDriver())
+		otp.Init(otpSender())
+		breach.Init(breachChecker())
+		middlewares.SetAccountChecker(service.GetUser())
+		middlewares.SetResourceResolver(service.GetUser())
+		jobs.NewJobRegistry(service).Start(context.Background())
 		controller := controllers.NewControllerRegistry(service)
 
 		router := gin.Default()
+		// Client IPs recorded for audit come from forwarding headers only when
+		// the request arrives through one of these proxies.
+		err = router.SetTrustedProxies(config.Config.TrustedProxies)
+		if err != nil {
+			panic(err)
+		}
 		router.Use(middlewares.HandlePanic())
 		router.NoRoute(func(c *gin.Context) {
 			c.JSON(http.StatusNotFound, response.Response{
@@ -70,7 +104,7 @@ var command = &cobra.Command{
 
 		router.Use(func(c *gin.Context) {
 			c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
-			c.Writer.Header().Set("Access-Control-Allow-Method", "GET, POST, PUT")
+			c.Writer.Header().Set("Access-Control-Allow-Method", "GET, POST, PUT, PATCH, DELETE")
 			c.Writer.Header().Set("Access-Control-Allow-Header", "Content-Type, Authorization, x-service-name, x-api-key, x-request-at")
 			c.Next()
 		})
@@ -98,6 +132,102 @@ var command = &cobra.Command{
 	},
 }
 
+func initDatabase() *gorm.DB {
+	_ = godotenv.Load()
+	config.Init()
+	db, err := config.InitDatabase()
+	if err != nil {
+		panic(err)
+	}
+
+	loc, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		panic(err)
+	}
+	time.Local = loc
+
+	return db
+}
+
+func policyLoader(repository repositories.IRepositoryRegistry) policy.Loader {
+	switch config.Config.Policy.Source {
+	case constants.PolicySourceFile:
+		return &policy.FileLoader{Path: config.Config.Policy.Path}
+	case constants.PolicySourceDatabase:
+		return repository.GetPolicy()
+	default:
+		return &policy.StaticLoader{Document: policy.DefaultDocument()}
+	}
+}
+
+func storageDriver() storage.Driver {
+	switch config.Config.Avatar.StorageDriver {
+	case constants.StorageDriverLocal, "":
+		driver := &storage.LocalDriver{Root: "storage/files", BaseURL: "/api/v1/files"}
+		if config.Config.Avatar.StoragePath != "" {
+			driver.Root = config.Config.Avatar.StoragePath
+		}
+		if config.Config.Avatar.BaseURL != "" {
+			driver.BaseURL = config.Config.Avatar.BaseURL
+		}
+		return driver
+	default:
+		panic(fmt.Sprintf("unknown storage driver %q", config.Config.Avatar.StorageDriver))
+	}
+}
+
+func otpSender() otp.Sender {
+	switch config.Config.Phone.OtpSender {
+	case constants.OtpSenderLog:
+		return otp.LogSender{}
+	case "":
+		panic("phone.otpSender is not configured")
+	default:
+		panic(fmt.Sprintf("unknown otp sender %q", config.Config.Phone.OtpSender))
+	}
+}
+
+func breachChecker() breach.Checker {
+	settings := config.Config.BreachedPassword
+	switch settings.Checker {
+	case "":
+		return breach.NoopChecker{}
+	case constants.BreachCheckerRange:
+		return &breach.RangeChecker{Dir: settings.Path, MinCount: settings.MinCount}
+	case constants.BreachCheckerBloom:
+		checker := &breach.BloomChecker{
+			Path:              settings.Path,
+			MinCount:          settings.MinCount,
+			FalsePositiveRate: settings.FalsePositiveRate,
+		}
+		// The corpus is loaded before serving so that no password is accepted
+		// unchecked; the refresh job only picks up later changes.
+		err := checker.Refresh(context.Background())
+		if err != nil {
+			panic(err)
+		}
+		return checker
+	default:
+		panic(fmt.Sprintf("unknown breached password checker %q", settings.Checker))
+	}
+}
+
+func passwordHasher() password.Hasher {
+	settings := config.Config.PasswordHash
+	switch settings.Algorithm {
+	case constants.PasswordHashArgon2id, "":
+		return &password.Argon2idHasher{
+			Memory:      settings.Argon2Memory,
+			Iterations:  settings.Argon2Iterations,
+			Parallelism: settings.Argon2Parallelism,
+		}
+	case constants.PasswordHashBcrypt:
+		return &password.BcryptHasher{Cost: settings.BcryptCost}
+	default:
+		panic(fmt.Sprintf("unknown password hash algorithm %q", settings.Algorithm))
+	}
+}
+
 func Run() {
 	err := command.Execute()
 	if err != nil {
@@ -0,0 +1,329 @@
+package breach
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	errConstant "user-service/constants/error"
+)
+
+const prefixLength = 5
+
+// Checker reports whether a password appears in a local copy of a breached
+// password corpus. Lookups never leave the machine.
+type Checker interface {
+	Breached(ctx context.Context, password string) (bool, error)
+	// Refresh reloads the corpus when its files changed on disk.
+	Refresh(ctx context.Context) error
+}
+
+// NoopChecker treats every password as not breached.
+type NoopChecker struct{}
+
+func (NoopChecker) Breached(context.Context, string) (bool, error) {
+	return false, nil
+}
+
+func (NoopChecker) Refresh(context.Context) error {
+	return nil
+}
+
+// RangeChecker looks passwords up in a directory of HIBP range files: one
+// file per five character SHA-1 prefix, named after the prefix with an
+// optional .txt extension, holding "SUFFIX:COUNT" lines. Files are read on
+// demand, so there is nothing to refresh.
+type RangeChecker struct {
+	Dir      string
+	MinCount int
+}
+
+func (r *RangeChecker) Breached(_ context.Context, password string) (bool, error) {
+	hash := Hash(password)
+	prefix, suffix := hash[:prefixLength], hash[prefixLength:]
+	for _, name := range []string{prefix, prefix + ".txt"} {
+		file, err := os.Open(filepath.Join(r.Dir, name))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+
+		found := false
+		err = scanFile(file, prefix, func(line string, count int) bool {
+			if line[prefixLength:] == suffix {
+				found = count >= minCount(r.MinCount)
+				return false
+			}
+			return true
+		})
+		file.Close()
+
+		return found, err
+	}
+
+	return false, nil
+}
+
+func (r *RangeChecker) Refresh(context.Context) error {
+	return nil
+}
+
+// BloomChecker keeps the hashes of a HIBP-format corpus in memory as a Bloom
+// filter. Path is either a file of "HASH:COUNT" lines or a directory of range
+// files; Refresh rebuilds the filter when their modification time changes.
+type BloomChecker struct {
+	Path              string
+	MinCount          int
+	FalsePositiveRate float64
+
+	mu      sync.RWMutex
+	filter  *bloomFilter
+	modTime time.Time
+}
+
+func (b *BloomChecker) Breached(_ context.Context, password string) (bool, error) {
+	b.mu.RLock()
+	filter := b.filter
+	b.mu.RUnlock()
+	if filter == nil {
+		return false, errConstant.ErrBreachCorpusNotLoaded
+	}
+
+	sum := sha1.Sum([]byte(password))
+	return filter.contains(sum[:]), nil
+}
+
+func (b *BloomChecker) Refresh(ctx context.Context) error {
+	modTime, err := latestModTime(b.Path)
+	if err != nil {
+		return err
+	}
+
+	b.mu.RLock()
+	current := b.filter != nil && b.modTime.Equal(modTime)
+	b.mu.RUnlock()
+	if current {
+		return nil
+	}
+
+	count := 0
+	err = walk(ctx, b.Path, func(string, int) bool {
+		count++
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	filter := newBloomFilter(count, b.FalsePositiveRate)
+	err = walk(ctx, b.Path, func(hash string, occurrences int) bool {
+		if occurrences < minCount(b.MinCount) {
+			return true
+		}
+		sum, err := hex.DecodeString(hash)
+		if err == nil && len(sum) == sha1.Size {
+			filter.add(sum)
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	b.filter = filter
+	b.modTime = modTime
+	b.mu.Unlock()
+
+	return nil
+}
+
+var (
+	defaultChecker Checker = NoopChecker{}
+	checkerMu      sync.RWMutex
+)
+
+func Init(checker Checker) {
+	checkerMu.Lock()
+	defaultChecker = checker
+	checkerMu.Unlock()
+}
+
+func Default() Checker {
+	checkerMu.RLock()
+	defer checkerMu.RUnlock()
+
+	return defaultChecker
+}
+
+// Hash returns the upper case hex SHA-1 of password, the form used by HIBP.
+func Hash(password string) string {
+	sum := sha1.Sum([]byte(password))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+func minCount(count int) int {
+	if count <= 0 {
+		return 1
+	}
+
+	return count
+}
+
+// walk calls fn with every full hash in path until fn returns false.
+func walk(ctx context.Context, path string, fn func(hash string, count int) bool) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		return scanFile(file, "", fn)
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		prefix := strings.ToUpper(strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name())))
+		if entry.IsDir() || len(prefix) != prefixLength {
+			continue
+		}
+
+		file, err := os.Open(filepath.Join(path, entry.Name()))
+		if err != nil {
+			return err
+		}
+		err = scanFile(file, prefix, fn)
+		file.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scanFile reads "HASH:COUNT" lines, prepending prefix to each hash, and
+// calls fn until it returns false.
+func scanFile(file *os.File, prefix string, fn func(hash string, count int) bool) error {
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		hash, value, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if hash == "" {
+			continue
+		}
+
+		count, err := strconv.Atoi(value)
+		if err != nil {
+			count = 1
+		}
+		if !fn(prefix+strings.ToUpper(hash), count) {
+			return nil
+		}
+	}
+
+	return scanner.Err()
+}
+
+func latestModTime(path string) (time.Time, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !info.IsDir() {
+		return info.ModTime(), nil
+	}
+
+	latest := info.ModTime()
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+
+	return latest, nil
+}
+
+type bloomFilter struct {
+	bits   []uint64
+	size   uint64
+	hashes uint64
+}
+
+func newBloomFilter(items int, falsePositiveRate float64) *bloomFilter {
+	if items < 1 {
+		items = 1
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = 0.001
+	}
+
+	size := uint64(math.Ceil(-float64(items) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	hashes := uint64(math.Max(1, math.Round(float64(size)/float64(items)*math.Ln2)))
+
+	return &bloomFilter{
+		bits:   make([]uint64, (size+63)/64),
+		size:   size,
+		hashes: hashes,
+	}
+}
+
+// SHA-1 output is already uniformly distributed, so its two halves serve as
+// the base hashes for double hashing.
+func (b *bloomFilter) positions(sum []byte, fn func(uint64) bool) bool {
+	first := binary.BigEndian.Uint64(sum[:8])
+	second := binary.BigEndian.Uint64(sum[8:16])
+	for i := uint64(0); i < b.hashes; i++ {
+		if !fn((first + i*second) % b.size) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (b *bloomFilter) add(sum []byte) {
+	b.positions(sum, func(position uint64) bool {
+		b.bits[position/64] |= 1 << (position % 64)
+		return true
+	})
+}
+
+func (b *bloomFilter) contains(sum []byte) bool {
+	return b.positions(sum, func(position uint64) bool {
+		return b.bits[position/64]&(1<<(position%64)) != 0
+	})
+}
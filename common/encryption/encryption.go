@@ -0,0 +1,61 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"user-service/config"
+	errConstant "user-service/constants/error"
+)
+
+// Encrypt seals plaintext with AES-256-GCM using the configured application
+// key and returns base64(nonce || ciphertext).
+func Encrypt(plaintext []byte) (string, error) {
+	aead, err := newAEAD()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, plaintext, nil)), nil
+}
+
+func Decrypt(value string) ([]byte, error) {
+	aead, err := newAEAD()
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || len(content) < aead.NonceSize() {
+		return nil, errConstant.ErrDecryptionFailed
+	}
+
+	plaintext, err := aead.Open(nil, content[:aead.NonceSize()], content[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, errConstant.ErrDecryptionFailed
+	}
+
+	return plaintext, nil
+}
+
+func newAEAD() (cipher.AEAD, error) {
+	key, err := base64.StdEncoding.DecodeString(config.Config.Encryption.Key)
+	if err != nil || len(key) != 32 {
+		return nil, fmt.Errorf("%w: key must be 32 bytes encoded as base64", errConstant.ErrEncryptionKeyInvalid)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -3,8 +3,10 @@ package error
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 
+	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 	"github.com/sirupsen/logrus"
 )
@@ -14,7 +16,130 @@ type ValidationResponse struct {
 	Message string `json:"message,omitempty"`
 }
 
-var ErrValidator = map[string]string{}
+var ErrValidator = map[string]string{
+	"min":      "%s must be at least %s",
+	"max":      "%s must be at most %s",
+	"oneof":    "%s must be one of %s",
+	"datetime": "%s must match format %s",
+	"uuid":     "%s must be a valid uuid",
+	"type":     "%s must be of type %s",
+	"len":      "%s must have length %s",
+	"gte":      "%s must be greater than or equal to %s",
+	"lte":      "%s must be less than or equal to %s",
+	"alpha":    "%s must contain only letters",
+	"alphanum": "%s must contain only letters and numbers",
+	"numeric":  "%s must be numeric",
+	"url":      "%s must be a valid url",
+	"unknown":  "%s is not a known attribute",
+	"e164":     "%s must be a valid phone number",
+
+	"password_length":   "%s must be at least %s characters",
+	"password_max":      "%s must be at most %s bytes",
+	"password_upper":    "%s must contain an uppercase letter",
+	"password_lower":    "%s must contain a lowercase letter",
+	"password_digit":    "%s must contain a digit",
+	"password_symbol":   "%s must contain a symbol",
+	"password_personal": "%s must not contain your username or email",
+	"password_strength": "%s is too easy to guess, strength score must be at least %s of 4",
+	"password_breached": "%s has appeared in a data breach, choose a different one",
+}
+
+// FieldError reports a validation failure on a value that is not a struct
+// field, such as a custom attribute, under the given Name. It either wraps an
+// error produced by validator.Var as Cause or describes a failed Rule directly.
+type FieldError struct {
+	Cause     validator.FieldError
+	Name      string
+	Rule      string
+	Parameter string
+}
+
+func (f *FieldError) Tag() string {
+	if f.Rule == "" && f.Cause != nil {
+		return f.Cause.Tag()
+	}
+
+	return f.Rule
+}
+
+func (f *FieldError) ActualTag() string {
+	if f.Rule == "" && f.Cause != nil {
+		return f.Cause.ActualTag()
+	}
+
+	return f.Rule
+}
+
+func (f *FieldError) Namespace() string {
+	return f.Name
+}
+
+func (f *FieldError) StructNamespace() string {
+	return f.Name
+}
+
+func (f *FieldError) Field() string {
+	return f.Name
+}
+
+func (f *FieldError) StructField() string {
+	return f.Name
+}
+
+func (f *FieldError) Value() interface{} {
+	if f.Cause != nil {
+		return f.Cause.Value()
+	}
+
+	return nil
+}
+
+func (f *FieldError) Param() string {
+	if f.Rule == "" && f.Cause != nil {
+		return f.Cause.Param()
+	}
+
+	return f.Parameter
+}
+
+func (f *FieldError) Kind() reflect.Kind {
+	if f.Cause != nil {
+		return f.Cause.Kind()
+	}
+
+	return reflect.Invalid
+}
+
+func (f *FieldError) Type() reflect.Type {
+	if f.Cause != nil {
+		return f.Cause.Type()
+	}
+
+	return nil
+}
+
+func (f *FieldError) Translate(ut.Translator) string {
+	return f.Error()
+}
+
+func (f *FieldError) Error() string {
+	return fmt.Sprintf("validation failed on %s: %s", f.Name, f.Tag())
+}
+
+// NameFieldErrors renames the field errors produced by validator.Var to name.
+func NameFieldErrors(name string, err error) validator.ValidationErrors {
+	var fieldErrors validator.ValidationErrors
+	if !errors.As(err, &fieldErrors) {
+		return nil
+	}
+
+	named := make(validator.ValidationErrors, 0, len(fieldErrors))
+	for _, item := range fieldErrors {
+		named = append(named, &FieldError{Cause: item, Name: name})
+	}
+
+	return named
+}
 
 func ErrValidationResponse(err error) (validationReponse []ValidationResponse) {
 	var fieldErrors validator.ValidationErrors
@@ -0,0 +1,57 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// Thumbnail center-crops src to a square, flattens transparency onto a white
+// background and scales it to size x size using an area-average filter.
+func Thumbnail(src image.Image, size int) *image.RGBA {
+	bounds := src.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	crop := image.Rect(0, 0, side, side)
+	offset := image.Pt(bounds.Min.X+(bounds.Dx()-side)/2, bounds.Min.Y+(bounds.Dy()-side)/2)
+
+	square := image.NewRGBA(crop)
+	draw.Draw(square, crop, image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(square, crop, src, offset, draw.Over)
+
+	return resize(square, size)
+}
+
+func resize(src *image.RGBA, size int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	side := src.Bounds().Dx()
+
+	for y := 0; y < size; y++ {
+		y0 := y * side / size
+		y1 := max((y+1)*side/size, y0+1)
+		for x := 0; x < size; x++ {
+			x0 := x * side / size
+			x1 := max((x+1)*side/size, x0+1)
+
+			var r, g, b, a, count uint32
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					pixel := row[sx*4 : sx*4+4]
+					r += uint32(pixel[0])
+					g += uint32(pixel[1])
+					b += uint32(pixel[2])
+					a += uint32(pixel[3])
+					count++
+				}
+			}
+
+			offset := dst.PixOffset(x, y)
+			dst.Pix[offset] = uint8(r / count)
+			dst.Pix[offset+1] = uint8(g / count)
+			dst.Pix[offset+2] = uint8(b / count)
+			dst.Pix[offset+3] = uint8(a / count)
+		}
+	}
+
+	return dst
+}
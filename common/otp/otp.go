@@ -0,0 +1,56 @@
+package otp
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Sender delivers a one-time code to a phone number, e.g. through an SMS or
+// WhatsApp gateway.
+type Sender interface {
+	Send(ctx context.Context, phoneNumber, code string) error
+}
+
+// LogSender writes codes to the application log. It is meant for local
+// development only.
+type LogSender struct{}
+
+func (LogSender) Send(_ context.Context, phoneNumber, code string) error {
+	logrus.Infof("otp for %s: %s", phoneNumber, code)
+	return nil
+}
+
+var (
+	defaultSender Sender = LogSender{}
+	senderMu      sync.RWMutex
+)
+
+func Init(sender Sender) {
+	senderMu.Lock()
+	defaultSender = sender
+	senderMu.Unlock()
+}
+
+func Default() Sender {
+	senderMu.RLock()
+	defer senderMu.RUnlock()
+
+	return defaultSender
+}
+
+func GenerateCode(length int) (string, error) {
+	code := make([]byte, length)
+	for i := range code {
+		digit, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		code[i] = byte('0' + digit.Int64())
+	}
+
+	return string(code), nil
+}
@@ -0,0 +1,32 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	errConstant "user-service/constants/error"
+)
+
+type Cursor struct {
+	Value string `json:"v"`
+	ID    uint   `json:"id"`
+}
+
+func EncodeCursor(cursor Cursor) string {
+	content, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(content)
+}
+
+func DecodeCursor(encoded string) (*Cursor, error) {
+	content, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errConstant.ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	err = json.Unmarshal(content, &cursor)
+	if err != nil {
+		return nil, errConstant.ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
@@ -0,0 +1,205 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	errConstant "user-service/constants/error"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	argon2idPrefix     = "$argon2id$"
+	defaultSaltLength  = 16
+	defaultKeyLength   = 32
+	defaultMemory      = 19 * 1024
+	defaultIterations  = 2
+	defaultParallelism = 1
+)
+
+// Hasher produces the encoded form of a password that is stored in the
+// database.
+type Hasher interface {
+	Hash(password string) (string, error)
+	// NeedsRehash reports whether encoded was produced by another algorithm
+	// or with other parameters than the hasher's own.
+	NeedsRehash(encoded string) bool
+}
+
+// Argon2idHasher encodes passwords in the PHC string format:
+// $argon2id$v=19$m=<memory KiB>,t=<iterations>,p=<parallelism>$<salt>$<key>.
+type Argon2idHasher struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+}
+
+func (a *Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, defaultSaltLength)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return "", err
+	}
+
+	memory, iterations, parallelism := a.params()
+	key := argon2.IDKey([]byte(password), salt, iterations, memory, parallelism, defaultKeyLength)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix, argon2.Version, memory, iterations, parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (a *Argon2idHasher) NeedsRehash(encoded string) bool {
+	hash, err := parseArgon2id(encoded)
+	if err != nil {
+		return true
+	}
+
+	memory, iterations, parallelism := a.params()
+	return hash.version != argon2.Version ||
+		hash.memory != memory ||
+		hash.iterations != iterations ||
+		hash.parallelism != parallelism ||
+		len(hash.salt) != defaultSaltLength ||
+		len(hash.key) != defaultKeyLength
+}
+
+func (a *Argon2idHasher) params() (memory, iterations uint32, parallelism uint8) {
+	memory, iterations, parallelism = a.Memory, a.Iterations, a.Parallelism
+	if memory == 0 {
+		memory = defaultMemory
+	}
+	if iterations == 0 {
+		iterations = defaultIterations
+	}
+	if parallelism == 0 {
+		parallelism = defaultParallelism
+	}
+
+	return memory, iterations, parallelism
+}
+
+// BcryptHasher keeps the modular crypt format bcrypt has always used
+// ($2a$<cost>$...), so hashes stored before argon2id support stay valid.
+type BcryptHasher struct {
+	Cost int
+}
+
+func (b *BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), b.cost())
+	if err != nil {
+		return "", err
+	}
+
+	return string(hash), nil
+}
+
+func (b *BcryptHasher) NeedsRehash(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	return err != nil || cost != b.cost()
+}
+
+func (b *BcryptHasher) cost() int {
+	if b.Cost < bcrypt.MinCost || b.Cost > bcrypt.MaxCost {
+		return bcrypt.DefaultCost
+	}
+
+	return b.Cost
+}
+
+var (
+	defaultHasher Hasher = &Argon2idHasher{}
+	hasherMu      sync.RWMutex
+)
+
+func Init(hasher Hasher) {
+	hasherMu.Lock()
+	defaultHasher = hasher
+	hasherMu.Unlock()
+}
+
+func Default() Hasher {
+	hasherMu.RLock()
+	defer hasherMu.RUnlock()
+
+	return defaultHasher
+}
+
+// Hash encodes password with the configured hasher.
+func Hash(password string) (string, error) {
+	return Default().Hash(password)
+}
+
+// NeedsRehash reports whether encoded is outdated for the configured hasher.
+func NeedsRehash(encoded string) bool {
+	return Default().NeedsRehash(encoded)
+}
+
+// Verify reports whether password matches encoded, whichever supported
+// algorithm and parameters produced it.
+func Verify(encoded, password string) (bool, error) {
+	if strings.HasPrefix(encoded, argon2idPrefix) {
+		hash, err := parseArgon2id(encoded)
+		if err != nil {
+			return false, err
+		}
+
+		key := argon2.IDKey([]byte(password), hash.salt, hash.iterations, hash.memory, hash.parallelism, uint32(len(hash.key)))
+		return subtle.ConstantTimeCompare(key, hash.key) == 1, nil
+	}
+
+	if _, err := bcrypt.Cost([]byte(encoded)); err == nil {
+		err = bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+
+	return false, errConstant.ErrUnknownPasswordHash
+}
+
+type argon2idHash struct {
+	version     int
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	salt        []byte
+	key         []byte
+}
+
+func parseArgon2id(encoded string) (*argon2idHash, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, errConstant.ErrUnknownPasswordHash
+	}
+
+	hash := &argon2idHash{}
+	_, err := fmt.Sscanf(parts[2], "v=%d", &hash.version)
+	if err != nil {
+		return nil, errConstant.ErrUnknownPasswordHash
+	}
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &hash.memory, &hash.iterations, &hash.parallelism)
+	if err != nil || hash.iterations == 0 || hash.parallelism == 0 {
+		return nil, errConstant.ErrUnknownPasswordHash
+	}
+
+	hash.salt, err = base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, errConstant.ErrUnknownPasswordHash
+	}
+	hash.key, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(hash.key) == 0 {
+		return nil, errConstant.ErrUnknownPasswordHash
+	}
+
+	return hash, nil
+}
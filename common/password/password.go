@@ -0,0 +1,246 @@
+package password
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+	"user-service/common/breach"
+	errWrap "user-service/common/error"
+	"user-service/config"
+	"user-service/constants"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultMinLength = 8
+	defaultMaxLength = 72
+	defaultMinScore  = 2
+	minPersonalRunes = 3
+	minWordRunes     = 4
+)
+
+// Validate checks password against the configured policy and the breached
+// password corpus and reports every violation under field. Personal holds
+// values, such as the username and email, that the password must not contain.
+func Validate(ctx context.Context, field, password string, personal ...string) validator.ValidationErrors {
+	var fieldErrors validator.ValidationErrors
+	report := func(rule, parameter string) {
+		fieldErrors = append(fieldErrors, &errWrap.FieldError{Name: field, Rule: rule, Parameter: parameter})
+	}
+
+	if len(password) > maxLength() {
+		report("password_max", strconv.Itoa(maxLength()))
+		return fieldErrors
+	}
+
+	policy := config.Config.Password
+	if utf8.RuneCountInString(password) < minLength() {
+		report("password_length", strconv.Itoa(minLength()))
+	}
+
+	upper, lower, digit, symbol := classes(password)
+	if policy.RequireUpper && !upper {
+		report("password_upper", "")
+	}
+	if policy.RequireLower && !lower {
+		report("password_lower", "")
+	}
+	if policy.RequireDigit && !digit {
+		report("password_digit", "")
+	}
+	if policy.RequireSymbol && !symbol {
+		report("password_symbol", "")
+	}
+
+	tokens := personalTokens(personal)
+	lowered := strings.ToLower(password)
+	for _, token := range tokens {
+		if strings.Contains(lowered, token) || strings.Contains(normalize(lowered), normalize(token)) {
+			report("password_personal", "")
+			break
+		}
+	}
+
+	if Score(password, personal...) < minScore() {
+		report("password_strength", strconv.Itoa(minScore()))
+	}
+
+	breached, err := breach.Default().Breached(ctx, password)
+	if err != nil {
+		logrus.Warnf("breached password check failed: %v", err)
+	}
+	if breached {
+		report("password_breached", "")
+	}
+
+	if len(fieldErrors) == 0 {
+		return nil
+	}
+
+	return fieldErrors
+}
+
+// Score estimates how hard password is to guess on the zxcvbn scale: 0 is
+// guessable within a thousand attempts and 4 needs more than ten billion.
+// Common passwords, dictionary words, personal values, repeats and sequences
+// count as a single guess each rather than per character.
+func Score(password string, personal ...string) int {
+	digits := guessDigits(password, personal)
+	switch {
+	case digits < 3:
+		return 0
+	case digits < 6:
+		return 1
+	case digits < 8:
+		return 2
+	case digits < 10:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// guessDigits returns log10 of the estimated number of guesses.
+func guessDigits(password string, personal []string) float64 {
+	if password == "" {
+		return 0
+	}
+
+	words, longest := dictionary(personal)
+	runes := []rune(strings.ToLower(password))
+	normalized := []rune(normalize(string(runes)))
+	charBits := math.Log2(float64(cardinality(password)))
+	wordBits := math.Log2(float64(len(words))) + 1
+
+	var bits float64
+	for i := 0; i < len(runes); {
+		if n := longestWord(normalized[i:], words, longest); n > 0 {
+			bits += wordBits
+			i += n
+			continue
+		}
+
+		if i > 0 && (runes[i] == runes[i-1] || runes[i]-runes[i-1] == 1 || runes[i-1]-runes[i] == 1) {
+			bits++
+		} else {
+			bits += charBits
+		}
+		i++
+	}
+
+	return bits * math.Log10(2)
+}
+
+// dictionary returns the normalized word list and the rune length of its
+// longest entry.
+func dictionary(personal []string) (map[string]bool, int) {
+	words := make(map[string]bool, len(constants.CommonPasswords)+len(personal))
+	longest := 0
+	for _, word := range append(slices.Clone(constants.CommonPasswords), personalTokens(personal)...) {
+		word = normalize(word)
+		words[word] = true
+		longest = max(longest, utf8.RuneCountInString(word))
+	}
+
+	return words, longest
+}
+
+func longestWord(runes []rune, words map[string]bool, longest int) int {
+	for n := min(len(runes), longest); n >= minWordRunes; n-- {
+		if words[string(runes[:n])] {
+			return n
+		}
+	}
+
+	return 0
+}
+
+func personalTokens(personal []string) []string {
+	var tokens []string
+	for _, value := range personal {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if local, _, ok := strings.Cut(value, "@"); ok {
+			value = local
+		}
+		if utf8.RuneCountInString(value) >= minPersonalRunes {
+			tokens = append(tokens, value)
+		}
+	}
+
+	return tokens
+}
+
+func classes(password string) (upper, lower, digit, symbol bool) {
+	for _, char := range password {
+		switch {
+		case unicode.IsUpper(char):
+			upper = true
+		case unicode.IsLower(char):
+			lower = true
+		case unicode.IsDigit(char):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	return upper, lower, digit, symbol
+}
+
+func cardinality(password string) int {
+	upper, lower, digit, symbol := classes(password)
+	size := 0
+	if upper {
+		size += 26
+	}
+	if lower {
+		size += 26
+	}
+	if digit {
+		size += 10
+	}
+	if symbol {
+		size += 33
+	}
+
+	return size
+}
+
+func normalize(value string) string {
+	return strings.Map(func(char rune) rune {
+		if replacement, ok := constants.LeetReplacer[char]; ok {
+			return replacement
+		}
+		return char
+	}, value)
+}
+
+func minLength() int {
+	if config.Config.Password.MinLength <= 0 {
+		return defaultMinLength
+	}
+
+	return config.Config.Password.MinLength
+}
+
+func maxLength() int {
+	if config.Config.Password.MaxLength <= 0 {
+		return defaultMaxLength
+	}
+
+	return config.Config.Password.MaxLength
+}
+
+func minScore() int {
+	if config.Config.Password.MinScore <= 0 {
+		return defaultMinScore
+	}
+
+	return config.Config.Password.MinScore
+}
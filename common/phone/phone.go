@@ -0,0 +1,88 @@
+package phone
+
+import (
+	"strings"
+	"user-service/config"
+	errConstant "user-service/constants/error"
+)
+
+const (
+	defaultRegion = "ID"
+	minDigits     = 8
+	maxDigits     = 15
+)
+
+var callingCodes = map[string]string{
+	"ID": "62",
+	"MY": "60",
+	"SG": "65",
+	"TH": "66",
+	"PH": "63",
+	"VN": "84",
+	"AU": "61",
+	"GB": "44",
+	"US": "1",
+}
+
+// Parse converts a phone number written in any of the usual local or
+// international forms ("0812...", "62 812...", "+62-812...") to E.164. Numbers
+// without a country code are read in the configured default region.
+func Parse(raw string) (string, error) {
+	value := strings.TrimSpace(raw)
+	if value == "" {
+		return "", nil
+	}
+
+	international := strings.HasPrefix(value, "+")
+	digits := strings.Map(func(char rune) rune {
+		switch char {
+		case ' ', '-', '.', '(', ')', '/', '+':
+			return -1
+		}
+		return char
+	}, value)
+	for _, char := range digits {
+		if char < '0' || char > '9' {
+			return "", errConstant.ErrInvalidPhoneNumber
+		}
+	}
+
+	code := callingCode()
+	switch {
+	case international:
+	case strings.HasPrefix(digits, "00"):
+		digits = digits[2:]
+	case strings.HasPrefix(digits, "0"):
+		digits = code + digits[1:]
+	case strings.HasPrefix(digits, code) && len(digits) >= minDigits+len(code):
+	default:
+		digits = code + digits
+	}
+
+	if len(digits) < minDigits || len(digits) > maxDigits || digits[0] == '0' {
+		return "", errConstant.ErrInvalidPhoneNumber
+	}
+
+	return "+" + digits, nil
+}
+
+// Normalize is Parse for request binding: a number that cannot be parsed is
+// returned unchanged so the e164 validation tag reports it.
+func Normalize(raw string) string {
+	normalized, err := Parse(raw)
+	if err != nil {
+		return raw
+	}
+
+	return normalized
+}
+
+func callingCode() string {
+	region := strings.ToUpper(config.Config.Phone.DefaultRegion)
+	code, ok := callingCodes[region]
+	if !ok {
+		return callingCodes[defaultRegion]
+	}
+
+	return code
+}
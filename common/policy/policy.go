@@ -0,0 +1,507 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	EffectAllow = "allow"
+	EffectDeny  = "deny"
+
+	OperatorEqual       = "eq"
+	OperatorNotEqual    = "ne"
+	OperatorIn          = "in"
+	OperatorNotIn       = "not_in"
+	OperatorContains    = "contains"
+	OperatorExists      = "exists"
+	OperatorGreater     = "gt"
+	OperatorGreaterOrEq = "gte"
+	OperatorLess        = "lt"
+	OperatorLessOrEq    = "lte"
+
+	Wildcard = "*"
+)
+
+type Attributes map[string]any
+
+type Document struct {
+	Version  int      `json:"version"`
+	Policies []Policy `json:"policies"`
+}
+
+type Policy struct {
+	ID          string      `json:"id"`
+	Description string      `json:"description"`
+	Effect      string      `json:"effect"`
+	Actions     []string    `json:"actions"`
+	Resources   []string    `json:"resources"`
+	Conditions  []Condition `json:"conditions"`
+}
+
+// Condition compares the attribute at Attribute (e.g. "subject.role") either
+// with the literal Value or, when Ref is set, with another attribute path.
+type Condition struct {
+	Attribute string `json:"attribute"`
+	Operator  string `json:"operator"`
+	Value     any    `json:"value,omitempty"`
+	Ref       string `json:"ref,omitempty"`
+}
+
+type Input struct {
+	Subject  Attributes
+	Action   string
+	Resource Attributes
+}
+
+type Decision struct {
+	Allowed  bool   `json:"allowed"`
+	Effect   string `json:"effect"`
+	PolicyID string `json:"policy_id,omitempty"`
+	Reason   string `json:"reason"`
+	Version  int    `json:"version"`
+}
+
+type Loader interface {
+	Load(context.Context) (*Document, error)
+}
+
+type Engine struct {
+	mu       sync.RWMutex
+	loader   Loader
+	document *Document
+}
+
+func NewEngine(loader Loader) *Engine {
+	return &Engine{
+		loader:   loader,
+		document: DefaultDocument(),
+	}
+}
+
+func (e *Engine) Load(ctx context.Context) error {
+	document, err := e.loader.Load(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = document.Validate()
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	previous := e.document.Version
+	e.document = document
+	e.mu.Unlock()
+
+	if previous != document.Version {
+		logrus.Infof("policy document version %d loaded", document.Version)
+	}
+
+	return nil
+}
+
+func (e *Engine) Watch(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := e.Load(ctx)
+			if err != nil {
+				logrus.Errorf("failed to reload policies: %v", err)
+			}
+		}
+	}
+}
+
+func (e *Engine) Evaluate(input Input) Decision {
+	e.mu.RLock()
+	document := e.document
+	e.mu.RUnlock()
+
+	resourceType, _ := input.Resource["type"].(string)
+	decision := Decision{
+		Allowed: false,
+		Effect:  EffectDeny,
+		Reason:  "no matching policy",
+		Version: document.Version,
+	}
+
+	for _, item := range document.Policies {
+		if !matchPattern(item.Actions, input.Action) || !matchPattern(item.Resources, resourceType) {
+			continue
+		}
+
+		if !item.matchConditions(input) {
+			continue
+		}
+
+		if item.Effect == EffectDeny {
+			decision = Decision{
+				Allowed:  false,
+				Effect:   EffectDeny,
+				PolicyID: item.ID,
+				Reason:   item.reason("denied by policy"),
+				Version:  document.Version,
+			}
+			break
+		}
+
+		if !decision.Allowed {
+			decision = Decision{
+				Allowed:  true,
+				Effect:   EffectAllow,
+				PolicyID: item.ID,
+				Reason:   item.reason("allowed by policy"),
+				Version:  document.Version,
+			}
+		}
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"subject":  input.Subject["uuid"],
+		"role":     input.Subject["role"],
+		"action":   input.Action,
+		"resource": resourceType,
+		"allowed":  decision.Allowed,
+		"policy":   decision.PolicyID,
+		"version":  decision.Version,
+	}).Info(decision.Reason)
+
+	return decision
+}
+
+func (d *Document) Validate() error {
+	for _, item := range d.Policies {
+		if item.ID == "" {
+			return fmt.Errorf("%w: policy id is required", errConstant.ErrInvalidPolicy)
+		}
+
+		if item.Effect != EffectAllow && item.Effect != EffectDeny {
+			return fmt.Errorf("%w: policy %s has unknown effect %q", errConstant.ErrInvalidPolicy, item.ID, item.Effect)
+		}
+
+		if len(item.Actions) == 0 || len(item.Resources) == 0 {
+			return fmt.Errorf("%w: policy %s requires actions and resources", errConstant.ErrInvalidPolicy, item.ID)
+		}
+
+		for _, condition := range item.Conditions {
+			if !isKnownOperator(condition.Operator) {
+				return fmt.Errorf("%w: policy %s has unknown operator %q", errConstant.ErrInvalidPolicy, item.ID, condition.Operator)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *Policy) reason(fallback string) string {
+	if p.Description != "" {
+		return p.Description
+	}
+
+	return fmt.Sprintf("%s %s", fallback, p.ID)
+}
+
+func (p *Policy) matchConditions(input Input) bool {
+	for _, condition := range p.Conditions {
+		if !condition.match(input) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *Condition) match(input Input) bool {
+	left, exists := lookup(input, c.Attribute)
+	if c.Operator == OperatorExists {
+		expected, ok := c.Value.(bool)
+		if !ok {
+			expected = true
+		}
+		return exists == expected
+	}
+
+	if !exists {
+		return false
+	}
+
+	right := c.Value
+	if c.Ref != "" {
+		right, exists = lookup(input, c.Ref)
+		if !exists {
+			return false
+		}
+	}
+
+	switch c.Operator {
+	case OperatorEqual:
+		return equal(left, right)
+	case OperatorNotEqual:
+		return !equal(left, right)
+	case OperatorIn:
+		return contains(right, left)
+	case OperatorNotIn:
+		return !contains(right, left)
+	case OperatorContains:
+		return contains(left, right)
+	case OperatorGreater, OperatorGreaterOrEq, OperatorLess, OperatorLessOrEq:
+		return compare(c.Operator, left, right)
+	}
+
+	return false
+}
+
+func lookup(input Input, path string) (any, bool) {
+	root, key, _ := strings.Cut(path, ".")
+	var attributes Attributes
+	switch root {
+	case "subject":
+		attributes = input.Subject
+	case "resource":
+		attributes = input.Resource
+	case "action":
+		return input.Action, key == ""
+	default:
+		return nil, false
+	}
+
+	value, ok := attributes[key]
+	if !ok || value == nil {
+		return nil, false
+	}
+
+	return value, true
+}
+
+func matchPattern(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if pattern == Wildcard || pattern == value {
+			return true
+		}
+
+		if prefix, ok := strings.CutSuffix(pattern, Wildcard); ok && strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func equal(left, right any) bool {
+	return fmt.Sprint(left) == fmt.Sprint(right)
+}
+
+func contains(collection, item any) bool {
+	if text, ok := collection.(string); ok {
+		return strings.Contains(text, fmt.Sprint(item))
+	}
+
+	value := reflect.ValueOf(collection)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return false
+	}
+
+	for i := 0; i < value.Len(); i++ {
+		if equal(value.Index(i).Interface(), item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func compare(operator string, left, right any) bool {
+	leftNumber, err := strconv.ParseFloat(fmt.Sprint(left), 64)
+	if err != nil {
+		return false
+	}
+
+	rightNumber, err := strconv.ParseFloat(fmt.Sprint(right), 64)
+	if err != nil {
+		return false
+	}
+
+	switch operator {
+	case OperatorGreater:
+		return leftNumber > rightNumber
+	case OperatorGreaterOrEq:
+		return leftNumber >= rightNumber
+	case OperatorLess:
+		return leftNumber < rightNumber
+	default:
+		return leftNumber <= rightNumber
+	}
+}
+
+func isKnownOperator(operator string) bool {
+	switch operator {
+	case OperatorEqual, OperatorNotEqual, OperatorIn, OperatorNotIn, OperatorContains, OperatorExists,
+		OperatorGreater, OperatorGreaterOrEq, OperatorLess, OperatorLessOrEq:
+		return true
+	}
+
+	return false
+}
+
+type FileLoader struct {
+	Path string
+}
+
+func (f *FileLoader) Load(_ context.Context) (*Document, error) {
+	content, err := os.ReadFile(f.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(content)
+}
+
+type StaticLoader struct {
+	Document *Document
+}
+
+func (s *StaticLoader) Load(_ context.Context) (*Document, error) {
+	return s.Document, nil
+}
+
+func Parse(content []byte) (*Document, error) {
+	var document Document
+	err := json.Unmarshal(content, &document)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errConstant.ErrInvalidPolicy, err)
+	}
+
+	return &document, nil
+}
+
+func DefaultDocument() *Document {
+	return &Document{
+		Version: 1,
+		Policies: []Policy{
+			{
+				ID:          "admin-full-access",
+				Description: "administrators can perform every action",
+				Effect:      EffectAllow,
+				Actions:     []string{Wildcard},
+				Resources:   []string{Wildcard},
+				Conditions: []Condition{
+					{Attribute: "subject.role", Operator: OperatorEqual, Value: constants.RoleCodeAdmin},
+				},
+			},
+			{
+				ID:          "user-manage-self",
+				Description: "users can read and manage their own account",
+				Effect:      EffectAllow,
+				Actions:     []string{constants.ActionUserRead, constants.ActionUserUpdate, constants.ActionUserUpdatePassword, constants.ActionUserReadMembership},
+				Resources:   []string{constants.ResourceUser},
+				Conditions: []Condition{
+					{Attribute: "subject.uuid", Operator: OperatorEqual, Ref: "resource.uuid"},
+				},
+			},
+			{
+				ID:          "user-read-any",
+				Description: "authenticated users can read user profiles",
+				Effect:      EffectAllow,
+				Actions:     []string{constants.ActionUserRead},
+				Resources:   []string{constants.ResourceUser},
+				Conditions: []Condition{
+					{Attribute: "subject.uuid", Operator: OperatorExists},
+				},
+			},
+			{
+				ID:          "user-read-hide-inactive",
+				Description: "only administrators can read accounts that are not active, except their own",
+				Effect:      EffectDeny,
+				Actions:     []string{constants.ActionUserRead},
+				Resources:   []string{constants.ResourceUser},
+				Conditions: []Condition{
+					{Attribute: "resource.status", Operator: OperatorNotEqual, Value: constants.UserStatusActive},
+					{Attribute: "subject.role", Operator: OperatorNotEqual, Value: constants.RoleCodeAdmin},
+					{Attribute: "subject.uuid", Operator: OperatorNotEqual, Ref: "resource.uuid"},
+				},
+			},
+		},
+	}
+}
+
+var (
+	defaultEngine = NewEngine(&StaticLoader{Document: DefaultDocument()})
+	engineMu      sync.RWMutex
+)
+
+func Init(ctx context.Context, loader Loader) (*Engine, error) {
+	engine := NewEngine(loader)
+	err := engine.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	engineMu.Lock()
+	defaultEngine = engine
+	engineMu.Unlock()
+
+	return engine, nil
+}
+
+func Evaluate(input Input) Decision {
+	engineMu.RLock()
+	engine := defaultEngine
+	engineMu.RUnlock()
+
+	return engine.Evaluate(input)
+}
+
+func SubjectFromUser(user *dto.UserResponse) Attributes {
+	if user == nil {
+		return Attributes{}
+	}
+
+	return Attributes{
+		"uuid":     user.UUID.String(),
+		"username": user.Username,
+		"email":    user.Email,
+		"role":     user.Role,
+		"status":   user.Status,
+		"minor":    user.Minor,
+	}
+}
+
+func SubjectFromContext(ctx context.Context) Attributes {
+	user, _ := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	return SubjectFromUser(user)
+}
+
+func Authorize(ctx context.Context, action string, resource Attributes) error {
+	decision := Evaluate(Input{
+		Subject:  SubjectFromContext(ctx),
+		Action:   action,
+		Resource: resource,
+	})
+	if !decision.Allowed {
+		return errConstant.ErrForbidden
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package response
 import (
 	"net/http"
 	"user-service/constants"
+	"user-service/domain/dto"
 
 	"github.com/gin-gonic/gin"
 
@@ -10,28 +11,31 @@ import (
 )
 
 type Response struct {
-	Status  string      `json:"status"`
-	Message string      `json:"message"`
-	Data    interface{} `json:"data"`
-	Token   *string     `json:"token,omitempty"`
+	Status     string                  `json:"status"`
+	Message    string                  `json:"message"`
+	Data       interface{}             `json:"data"`
+	Token      *string                 `json:"token,omitempty"`
+	Pagination *dto.PaginationResponse `json:"pagination,omitempty"`
 }
 
 type ParamHttpResponse struct {
-	Code    int
-	Error   error
-	Message *string
-	Gin     *gin.Context
-	Data    interface{}
-	Token   *string
+	Code       int
+	Error      error
+	Message    *string
+	Gin        *gin.Context
+	Data       interface{}
+	Token      *string
+	Pagination *dto.PaginationResponse
 }
 
 func HttpResponse(param ParamHttpResponse) {
 	if param.Error == nil {
 		param.Gin.JSON(param.Code, Response{
-			Status:  constants.Success,
-			Message: http.StatusText(http.StatusOK),
-			Data:    param.Data,
-			Token:   param.Token,
+			Status:     constants.Success,
+			Message:    http.StatusText(http.StatusOK),
+			Data:       param.Data,
+			Token:      param.Token,
+			Pagination: param.Pagination,
 		})
 		return
 	}
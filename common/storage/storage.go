@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+type Driver interface {
+	Put(ctx context.Context, key string, reader io.Reader) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
+
+type LocalDriver struct {
+	Root    string
+	BaseURL string
+}
+
+func (l *LocalDriver) Put(_ context.Context, key string, reader io.Reader) error {
+	path := l.path(key)
+	err := os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, reader)
+	if err != nil {
+		file.Close()
+		os.Remove(path)
+		return err
+	}
+
+	return file.Close()
+}
+
+func (l *LocalDriver) Delete(_ context.Context, key string) error {
+	err := os.Remove(l.path(key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (l *LocalDriver) URL(key string) string {
+	return strings.TrimSuffix(l.BaseURL, "/") + "/" + key
+}
+
+func (l *LocalDriver) path(key string) string {
+	return filepath.Join(l.Root, filepath.FromSlash(filepath.Clean("/"+key)))
+}
+
+var (
+	defaultDriver Driver = &LocalDriver{Root: "storage/files", BaseURL: "/api/v1/files"}
+	driverMu      sync.RWMutex
+)
+
+func Init(driver Driver) {
+	driverMu.Lock()
+	defaultDriver = driver
+	driverMu.Unlock()
+}
+
+func Default() Driver {
+	driverMu.RLock()
+	defer driverMu.RUnlock()
+
+	return defaultDriver
+}
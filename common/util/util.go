@@ -100,3 +100,17 @@ func BindFromConsul(dest any, endPoint, path string) error {
 
 	return nil
 }
+
+// Truncate shortens value to at most length characters without splitting a
+// multi-byte character, matching how varchar(length) counts.
+func Truncate(value string, length int) string {
+	count := 0
+	for index := range value {
+		if count == length {
+			return value[:index]
+		}
+		count++
+	}
+
+	return value
+}
@@ -10,15 +10,33 @@ import (
 var Config AppConfig
 
 type AppConfig struct {
-	Port                int      `json:"port"`
-	AppName             string   `json:"appName"`
-	AppEnv              string   `json:"appEnv"`
-	SignatureKey        string   `json:"signatureKey"`
-	Database            Database `json:"database"`
-	RateLimitMaxRequest float64  `json:"rateLimitMaxRequest"`
-	RateLimitTimeSecond int      `json:"rateLimitTimeSecond"`
-	JwtSecret           string   `json:"jwtSecret"`
-	JwtExpirationTime   int      `json:"jwtExpirationTime"`
+	Port                int              `json:"port"`
+	AppName             string           `json:"appName"`
+	AppEnv              string           `json:"appEnv"`
+	SignatureKey        string           `json:"signatureKey"`
+	Database            Database         `json:"database"`
+	RateLimitMaxRequest float64          `json:"rateLimitMaxRequest"`
+	RateLimitTimeSecond int              `json:"rateLimitTimeSecond"`
+	TrustedProxies      []string         `json:"trustedProxies"`
+	JwtSecret           string           `json:"jwtSecret"`
+	JwtExpirationTime   int              `json:"jwtExpirationTime"`
+	Policy              Policy           `json:"policy"`
+	Clients             []Client         `json:"clients"`
+	DefaultScopes       []string         `json:"defaultScopes"`
+	AccountDeletion     AccountDeletion  `json:"accountDeletion"`
+	DataExport          DataExport       `json:"dataExport"`
+	UserImport          UserImport       `json:"userImport"`
+	UserLookup          UserLookup       `json:"userLookup"`
+	Avatar              Avatar           `json:"avatar"`
+	Guardian            Guardian         `json:"guardian"`
+	Encryption          Encryption       `json:"encryption"`
+	Membership          Membership       `json:"membership"`
+	Suspension          Suspension       `json:"suspension"`
+	Username            Username         `json:"username"`
+	Phone               Phone            `json:"phone"`
+	Password            Password         `json:"password"`
+	BreachedPassword    BreachedPassword `json:"breachedPassword"`
+	PasswordHash        PasswordHash     `json:"passwordHash"`
 }
 
 type Database struct {
@@ -33,6 +51,84 @@ type Database struct {
 	MaxIdleTime           int    `json:"maxIdleTime"`
 }
 
+type Policy struct {
+	Source               string `json:"source"`
+	Path                 string `json:"path"`
+	ReloadIntervalSecond int    `json:"reloadIntervalSecond"`
+}
+
+type Client struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Secret string   `json:"secret"`
+	Scopes []string `json:"scopes"`
+}
+
+type AccountDeletion struct {
+	GracePeriodDay    int `json:"gracePeriodDay"`
+	JobIntervalMinute int `json:"jobIntervalMinute"`
+	JobBatchSize      int `json:"jobBatchSize"`
+}
+
+type DataExport struct {
+	StoragePath          string `json:"storagePath"`
+	BaseURL              string `json:"baseUrl"`
+	LinkExpirationMinute int    `json:"linkExpirationMinute"`
+	RetentionHour        int    `json:"retentionHour"`
+	JobIntervalSecond    int    `json:"jobIntervalSecond"`
+	StaleMinute          int    `json:"staleMinute"`
+}
+
+type UserImport struct {
+	BatchSize     int   `json:"batchSize"`
+	MaxUploadByte int64 `json:"maxUploadByte"`
+}
+
+type UserLookup struct {
+	MaxBatchSize int `json:"maxBatchSize"`
+}
+
+type Avatar struct {
+	StorageDriver string `json:"storageDriver"`
+	StoragePath   string `json:"storagePath"`
+	BaseURL       string `json:"baseUrl"`
+	MaxUploadByte int64  `json:"maxUploadByte"`
+	MaxDimension  int    `json:"maxDimension"`
+}
+
+type Guardian struct {
+	AgeOfMajority int `json:"ageOfMajority"`
+}
+
+type Encryption struct {
+	Key string `json:"key"`
+}
+
+type Membership struct {
+	JobIntervalMinute int `json:"jobIntervalMinute"`
+}
+
+type Username struct {
+	Reserved          []string `json:"reserved"`
+	Blocked           []string `json:"blocked"`
+	RenameCooldownDay int      `json:"renameCooldownDay"`
+	HoldPeriodDay     int      `json:"holdPeriodDay"`
+}
+
+type Phone struct {
+	DefaultRegion       string `json:"defaultRegion"`
+	OtpSender           string `json:"otpSender"`
+	OtpLength           int    `json:"otpLength"`
+	OtpExpirationMinute int    `json:"otpExpirationMinute"`
+	OtpResendSecond     int    `json:"otpResendSecond"`
+	OtpMaxAttempt       int    `json:"otpMaxAttempt"`
+}
+
+type Suspension struct {
+	JobIntervalMinute int `json:"jobIntervalMinute"`
+	JobBatchSize      int `json:"jobBatchSize"`
+}
+
 func Init() {
 	err := util.BindFromJson(&Config, "config.json", ".")
 	if err != nil {
@@ -45,3 +141,30 @@ func Init() {
 	}
 
 }
+
+type Password struct {
+	MinLength     int  `json:"minLength"`
+	MaxLength     int  `json:"maxLength"`
+	RequireUpper  bool `json:"requireUpper"`
+	RequireLower  bool `json:"requireLower"`
+	RequireDigit  bool `json:"requireDigit"`
+	RequireSymbol bool `json:"requireSymbol"`
+	MinScore      int  `json:"minScore"`
+}
+
+type BreachedPassword struct {
+	Checker               string  `json:"checker"`
+	Path                  string  `json:"path"`
+	MinCount              int     `json:"minCount"`
+	FalsePositiveRate     float64 `json:"falsePositiveRate"`
+	RefreshIntervalMinute int     `json:"refreshIntervalMinute"`
+	CheckOnLogin          bool    `json:"checkOnLogin"`
+}
+
+type PasswordHash struct {
+	Algorithm         string `json:"algorithm"`
+	BcryptCost        int    `json:"bcryptCost"`
+	Argon2Memory      uint32 `json:"argon2Memory"`
+	Argon2Iterations  uint32 `json:"argon2Iterations"`
+	Argon2Parallelism uint8  `json:"argon2Parallelism"`
+}
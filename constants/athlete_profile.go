@@ -0,0 +1,8 @@
+package constants
+
+const (
+	SkillSourceSelfAssessed = "self_assessed"
+	SkillSourceVerified     = "verified"
+
+	IncludeAthleteProfile = "athlete_profile"
+)
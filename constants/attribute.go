@@ -0,0 +1,16 @@
+package constants
+
+const (
+	AttributeTypeString  = "string"
+	AttributeTypeInteger = "integer"
+	AttributeTypeNumber  = "number"
+	AttributeTypeBoolean = "boolean"
+	AttributeTypeDate    = "date"
+	AttributeTypeEnum    = "enum"
+
+	AttributeVisibilityPublic  = "public"
+	AttributeVisibilityPrivate = "private"
+	AttributeVisibilityAdmin   = "admin"
+)
+
+var AttributeRules = []string{"min", "max", "len", "gte", "lte", "alpha", "alphanum", "numeric", "email", "url"}
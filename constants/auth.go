@@ -3,4 +3,5 @@ package constants
 const (
 	UserLogin = "user_login"
 	Token     = "token"
+	Claims    = "claims"
 )
\ No newline at end of file
@@ -0,0 +1,15 @@
+package constants
+
+const (
+	StorageDriverLocal = "local"
+
+	AvatarSizeSmall  = "small"
+	AvatarSizeMedium = "medium"
+	AvatarSizeLarge  = "large"
+)
+
+var AvatarSizes = map[string]int{
+	AvatarSizeSmall:  64,
+	AvatarSizeMedium: 256,
+	AvatarSizeLarge:  512,
+}
@@ -0,0 +1,6 @@
+package constants
+
+const (
+	ConsentTypeTerms   = "terms"
+	ConsentTypePrivacy = "privacy"
+)
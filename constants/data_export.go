@@ -0,0 +1,9 @@
+package constants
+
+const (
+	DataExportStatusPending    = "pending"
+	DataExportStatusProcessing = "processing"
+	DataExportStatusReady      = "ready"
+	DataExportStatusFailed     = "failed"
+	DataExportStatusExpired    = "expired"
+)
@@ -0,0 +1,6 @@
+package constants
+
+const (
+	EmergencyAccessActionRead   = "read"
+	EmergencyAccessActionDenied = "denied"
+)
@@ -0,0 +1,13 @@
+package error
+
+import "errors"
+
+var (
+	ErrAthleteProfileNotFound = errors.New("athlete profile not found")
+	ErrAthleteProfileExists   = errors.New("athlete profile already exists")
+	ErrDuplicateSport         = errors.New("sport is listed more than once")
+)
+
+var AthleteProfileErrors = []error{
+	ErrAthleteProfileNotFound, ErrAthleteProfileExists, ErrDuplicateSport,
+}
@@ -0,0 +1,14 @@
+package error
+
+import "errors"
+
+var (
+	ErrAttributeNotFound     = errors.New("attribute not found")
+	ErrAttributeKeyExists    = errors.New("attribute key already exists")
+	ErrInvalidAttributeRule  = errors.New("invalid attribute rule")
+	ErrAttributeOptionsEmpty = errors.New("enum attribute requires options")
+)
+
+var AttributeErrors = []error{
+	ErrAttributeNotFound, ErrAttributeKeyExists, ErrInvalidAttributeRule, ErrAttributeOptionsEmpty,
+}
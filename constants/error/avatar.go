@@ -0,0 +1,15 @@
+package error
+
+import "errors"
+
+var (
+	ErrAvatarRequired       = errors.New("avatar file is required")
+	ErrAvatarTooLarge       = errors.New("avatar file is too large")
+	ErrUnsupportedImageType = errors.New("unsupported image type")
+	ErrInvalidImage         = errors.New("invalid image")
+	ErrAvatarNotFound       = errors.New("avatar not found")
+)
+
+var AvatarErrors = []error{
+	ErrAvatarRequired, ErrAvatarTooLarge, ErrUnsupportedImageType, ErrInvalidImage, ErrAvatarNotFound,
+}
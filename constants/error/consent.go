@@ -0,0 +1,12 @@
+package error
+
+import "errors"
+
+var (
+	ErrConsentDocumentNotFound = errors.New("consent document not found")
+	ErrConsentVersionExists    = errors.New("consent document version already exists")
+)
+
+var ConsentErrors = []error{
+	ErrConsentDocumentNotFound, ErrConsentVersionExists,
+}
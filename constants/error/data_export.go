@@ -0,0 +1,14 @@
+package error
+
+import "errors"
+
+var (
+	ErrDataExportNotFound = errors.New("data export not found")
+	ErrDataExportNotReady = errors.New("data export is not ready")
+	ErrInvalidSignature   = errors.New("invalid signature")
+	ErrLinkExpired        = errors.New("link expired")
+)
+
+var DataExportErrors = []error{
+	ErrDataExportNotFound, ErrDataExportNotReady, ErrInvalidSignature, ErrLinkExpired,
+}
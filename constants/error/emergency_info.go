@@ -0,0 +1,17 @@
+package error
+
+import "errors"
+
+var (
+	ErrEmergencyInfoNotFound = errors.New("emergency info not found")
+	ErrAccessGrantNotFound   = errors.New("access grant not found")
+	ErrAccessGrantExists     = errors.New("access grant already exists")
+	ErrCannotGrantSelf       = errors.New("cannot grant access to yourself")
+	ErrEncryptionKeyInvalid  = errors.New("encryption key is invalid")
+	ErrDecryptionFailed      = errors.New("failed to decrypt data")
+)
+
+var EmergencyInfoErrors = []error{
+	ErrEmergencyInfoNotFound, ErrAccessGrantNotFound, ErrAccessGrantExists, ErrCannotGrantSelf,
+	ErrEncryptionKeyInvalid, ErrDecryptionFailed,
+}
@@ -3,7 +3,22 @@ package error
 import "errors"
 
 func ErrMapping(err error) bool {
-	allErrors := append(GeneralErrors, UserErrors...)
+	allErrors := make([]error, 0)
+	allErrors = append(allErrors, GeneralErrors...)
+	allErrors = append(allErrors, UserErrors...)
+	allErrors = append(allErrors, PolicyErrors...)
+	allErrors = append(allErrors, DataExportErrors...)
+	allErrors = append(allErrors, AvatarErrors...)
+	allErrors = append(allErrors, AthleteProfileErrors...)
+	allErrors = append(allErrors, GuardianErrors...)
+	allErrors = append(allErrors, EmergencyInfoErrors...)
+	allErrors = append(allErrors, PreferenceErrors...)
+	allErrors = append(allErrors, AttributeErrors...)
+	allErrors = append(allErrors, MembershipErrors...)
+	allErrors = append(allErrors, ConsentErrors...)
+	allErrors = append(allErrors, SuspensionErrors...)
+	allErrors = append(allErrors, UsernameErrors...)
+	allErrors = append(allErrors, PhoneErrors...)
 
 	for _, item := range allErrors {
 		if errors.Is(err, item) {
@@ -9,8 +9,12 @@ var (
 	ErrUnauthorized        = errors.New("unauthorized")
 	ErrInvalidToken        = errors.New("invalid token")
 	ErrForbidden           = errors.New("forbidden")
+	ErrInsufficientScope   = errors.New("insufficient scope")
+	ErrInvalidCursor       = errors.New("invalid cursor")
+	ErrInvalidInclude      = errors.New("invalid include")
 )
 
 var GeneralErrors = []error{
-	ErrInternalServerError, ErrSqlError, ErrToManyRequest, ErrUnauthorized, ErrInvalidToken, ErrForbidden,
+	ErrInternalServerError, ErrSqlError, ErrToManyRequest, ErrUnauthorized, ErrInvalidToken, ErrForbidden, ErrInsufficientScope,
+	ErrInvalidCursor, ErrInvalidInclude,
 }
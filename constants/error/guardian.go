@@ -0,0 +1,19 @@
+package error
+
+import "errors"
+
+var (
+	ErrGuardianRequired       = errors.New("guardian email is required for minors")
+	ErrGuardianNotFound       = errors.New("guardian not found")
+	ErrGuardianIsMinor        = errors.New("guardian must be an adult")
+	ErrNotGuardian            = errors.New("user is not a guardian of this minor")
+	ErrApprovalNotFound       = errors.New("guardian approval not found")
+	ErrApprovalAlreadyDecided = errors.New("guardian approval is already decided")
+	ErrMinorImportNotAllowed  = errors.New("minors must register with a guardian")
+	ErrMinorChangeNotAllowed  = errors.New("minors can only change their profile with guardian approval")
+)
+
+var GuardianErrors = []error{
+	ErrGuardianRequired, ErrGuardianNotFound, ErrGuardianIsMinor, ErrNotGuardian, ErrApprovalNotFound,
+	ErrApprovalAlreadyDecided, ErrMinorImportNotAllowed, ErrMinorChangeNotAllowed,
+}
@@ -0,0 +1,12 @@
+package error
+
+import "errors"
+
+var (
+	ErrMembershipNotFound      = errors.New("membership not found")
+	ErrInvalidMembershipPeriod = errors.New("membership must expire after it starts")
+)
+
+var MembershipErrors = []error{
+	ErrMembershipNotFound, ErrInvalidMembershipPeriod,
+}
@@ -0,0 +1,11 @@
+package error
+
+import "errors"
+
+// ErrUnknownPasswordHash means a stored hash was not produced by any supported
+// algorithm. It is an internal error and is deliberately not mapped.
+var ErrUnknownPasswordHash = errors.New("unknown password hash format")
+
+// ErrBreachCorpusNotLoaded means a password was checked before the breached
+// password corpus was loaded. It is likewise not mapped.
+var ErrBreachCorpusNotLoaded = errors.New("breached password corpus is not loaded")
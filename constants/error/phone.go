@@ -0,0 +1,19 @@
+package error
+
+import "errors"
+
+var (
+	ErrInvalidPhoneNumber        = errors.New("invalid phone number")
+	ErrPhoneNumberNotSet         = errors.New("phone number is not set")
+	ErrPhoneAlreadyVerified      = errors.New("phone number is already verified")
+	ErrPhoneVerificationNotFound = errors.New("phone verification not found")
+	ErrOtpResendTooSoon          = errors.New("verification code was sent recently, please wait")
+	ErrOtpInvalid                = errors.New("verification code is invalid")
+	ErrOtpExpired                = errors.New("verification code has expired")
+	ErrOtpTooManyAttempts        = errors.New("too many invalid verification attempts, request a new code")
+)
+
+var PhoneErrors = []error{
+	ErrInvalidPhoneNumber, ErrPhoneNumberNotSet, ErrPhoneAlreadyVerified, ErrPhoneVerificationNotFound,
+	ErrOtpResendTooSoon, ErrOtpInvalid, ErrOtpExpired, ErrOtpTooManyAttempts,
+}
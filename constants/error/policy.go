@@ -0,0 +1,12 @@
+package error
+
+import "errors"
+
+var (
+	ErrPolicyNotFound = errors.New("policy not found")
+	ErrInvalidPolicy  = errors.New("invalid policy")
+)
+
+var PolicyErrors = []error{
+	ErrPolicyNotFound, ErrInvalidPolicy,
+}
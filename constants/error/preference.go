@@ -0,0 +1,13 @@
+package error
+
+import "errors"
+
+var (
+	ErrPreferenceNotFound = errors.New("preference not found")
+	ErrUnknownPreference  = errors.New("unknown preference")
+	ErrInvalidPreference  = errors.New("invalid preference value")
+)
+
+var PreferenceErrors = []error{
+	ErrPreferenceNotFound, ErrUnknownPreference, ErrInvalidPreference,
+}
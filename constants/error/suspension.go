@@ -0,0 +1,14 @@
+package error
+
+import "errors"
+
+var (
+	ErrSuspensionNotFound      = errors.New("suspension not found")
+	ErrUserAlreadySuspended    = errors.New("user is already suspended")
+	ErrUserNotActive           = errors.New("only active users can be suspended")
+	ErrInvalidSuspensionExpiry = errors.New("suspension must expire in the future")
+)
+
+var SuspensionErrors = []error{
+	ErrSuspensionNotFound, ErrUserAlreadySuspended, ErrUserNotActive, ErrInvalidSuspensionExpiry,
+}
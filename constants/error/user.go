@@ -3,13 +3,27 @@ package error
 import "errors"
 
 var (
-	ErrNotFound           = errors.New("user not found")
-	ErrInvalidPassword    = errors.New("password invalid")
-	ErrUsernameExists     = errors.New("username already exists")
-	ErrEmailExists        = errors.New("email already exists")
-	ErrPasswordIsNotMatch = errors.New("password does not match")
+	ErrNotFound            = errors.New("user not found")
+	ErrInvalidPassword     = errors.New("password invalid")
+	ErrUsernameExists      = errors.New("username already exists")
+	ErrEmailExists         = errors.New("email already exists")
+	ErrPasswordIsNotMatch  = errors.New("password does not match")
+	ErrInvalidClient       = errors.New("invalid client")
+	ErrUserDeactivated     = errors.New("user is deactivated")
+	ErrUserSuspended       = errors.New("user is suspended")
+	ErrUserNotDeleted      = errors.New("user is not deleted")
+	ErrUserPendingDeletion = errors.New("user is scheduled for deletion, log in again to cancel it")
+	ErrInvalidImportFormat = errors.New("invalid import format")
+	ErrImportFileRequired  = errors.New("import file is required")
+	ErrInvalidExportColumn = errors.New("invalid export column")
+	ErrBatchSizeExceeded   = errors.New("too many uuids requested")
+	ErrUserPendingApproval = errors.New("user is waiting for guardian approval")
+	ErrDateOfBirthSet      = errors.New("date of birth is already set")
 )
 
 var UserErrors = []error{
-	ErrNotFound, ErrInvalidPassword, ErrUsernameExists, ErrPasswordIsNotMatch,
+	ErrNotFound, ErrInvalidPassword, ErrUsernameExists, ErrEmailExists, ErrPasswordIsNotMatch, ErrInvalidClient,
+	ErrUserDeactivated, ErrUserSuspended, ErrUserNotDeleted, ErrUserPendingDeletion,
+	ErrInvalidImportFormat, ErrImportFileRequired, ErrInvalidExportColumn,
+	ErrBatchSizeExceeded, ErrUserPendingApproval, ErrDateOfBirthSet,
 }
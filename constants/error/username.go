@@ -0,0 +1,14 @@
+package error
+
+import "errors"
+
+var (
+	ErrUsernameReserved   = errors.New("username is reserved")
+	ErrUsernameNotAllowed = errors.New("username contains a blocked word")
+	ErrUsernameOnHold     = errors.New("username was released recently and is on hold")
+	ErrUsernameCooldown   = errors.New("username was changed recently")
+)
+
+var UsernameErrors = []error{
+	ErrUsernameReserved, ErrUsernameNotAllowed, ErrUsernameOnHold, ErrUsernameCooldown,
+}
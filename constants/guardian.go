@@ -0,0 +1,10 @@
+package constants
+
+const (
+	GuardianApprovalTypeRegistration  = "registration"
+	GuardianApprovalTypeProfileUpdate = "profile_update"
+
+	GuardianApprovalStatusPending  = "pending"
+	GuardianApprovalStatusApproved = "approved"
+	GuardianApprovalStatusRejected = "rejected"
+)
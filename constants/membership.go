@@ -0,0 +1,30 @@
+package constants
+
+const (
+	MembershipTierPremium = "premium"
+	MembershipTierElite   = "elite"
+
+	MembershipStatusActive  = "active"
+	MembershipStatusExpired = "expired"
+	MembershipStatusRevoked = "revoked"
+
+	MembershipActorService   = "service"
+	MembershipReasonReplaced = "replaced by a new membership"
+
+	EntitlementBookingPriority   = "booking:priority"
+	EntitlementBookingDiscount10 = "booking:discount_10"
+	EntitlementBookingDiscount20 = "booking:discount_20"
+	EntitlementFreeCancellation  = "booking:free_cancellation"
+)
+
+var MembershipEntitlements = map[string][]string{
+	MembershipTierPremium: {
+		EntitlementBookingPriority,
+		EntitlementBookingDiscount10,
+	},
+	MembershipTierElite: {
+		EntitlementBookingPriority,
+		EntitlementBookingDiscount20,
+		EntitlementFreeCancellation,
+	},
+}
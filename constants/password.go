@@ -0,0 +1,26 @@
+package constants
+
+// CommonPasswords lists passwords and words that appear at the top of leaked
+// password lists. Passwords made of them are guessed first.
+var CommonPasswords = []string{
+	"123456", "1234567", "12345678", "123456789", "1234567890", "111111", "000000",
+	"123123", "654321", "112233", "121212", "666666", "888888", "abc123", "qwerty",
+	"qwertyuiop", "asdfgh", "asdfghjkl", "zxcvbnm", "1q2w3e4r", "qazwsx", "password",
+	"passw0rd", "letmein", "welcome", "login", "admin", "master", "secret", "iloveyou",
+	"monkey", "dragon", "shadow", "sunshine", "princess", "football", "baseball",
+	"soccer", "superman", "batman", "trustno1", "hello", "freedom", "whatever",
+	"starwars", "michael", "charlie", "jordan", "pokemon", "summer", "winter",
+	"spring", "autumn", "flower", "computer", "internet", "changeme", "default",
+	"jakarta", "indonesia", "sayang", "rahasia", "bismillah", "cinta", "katasandi",
+	"sepakbola", "garuda", "merdeka",
+}
+
+const (
+	BreachCheckerRange = "range"
+	BreachCheckerBloom = "bloom"
+)
+
+const (
+	PasswordHashArgon2id = "argon2id"
+	PasswordHashBcrypt   = "bcrypt"
+)
@@ -0,0 +1,31 @@
+package constants
+
+const (
+	PolicySourceFile     = "file"
+	PolicySourceDatabase = "database"
+)
+
+const (
+	ResourceUser      = "user"
+	ResourceAttribute = "attribute"
+	ResourceConsent   = "consent"
+
+	ActionUserRead             = "user:read"
+	ActionUserList             = "user:list"
+	ActionUserSearch           = "user:search"
+	ActionUserDelete           = "user:delete"
+	ActionUserRestore          = "user:restore"
+	ActionUserDeactivate       = "user:deactivate"
+	ActionUserReactivate       = "user:reactivate"
+	ActionUserImport           = "user:import"
+	ActionUserExport           = "user:export"
+	ActionUserUpdate           = "user:update"
+	ActionUserUpdatePassword   = "user:update_password"
+	ActionUserVerifySkill      = "user:verify_skill"
+	ActionUserManageMembership = "user:manage_membership"
+	ActionUserReadMembership   = "user:read_membership"
+	ActionUserSuspend          = "user:suspend"
+
+	ActionAttributeManage = "attribute:manage"
+	ActionConsentManage   = "consent:manage"
+)
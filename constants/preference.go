@@ -0,0 +1,10 @@
+package constants
+
+const (
+	PreferenceTypeString     = "string"
+	PreferenceTypeBoolean    = "boolean"
+	PreferenceTypeInteger    = "integer"
+	PreferenceTypeStringList = "string_list"
+
+	PreferenceFormatTimezone = "timezone"
+)
@@ -4,3 +4,8 @@ const (
 	Admin    = 1
 	Customer = 2
 )
+
+const (
+	RoleCodeAdmin    = "admin"
+	RoleCodeCustomer = "cust"
+)
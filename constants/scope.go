@@ -0,0 +1,39 @@
+package constants
+
+const (
+	ScopeProfileRead  = "profile:read"
+	ScopeProfileWrite = "profile:write"
+	ScopeUsersRead    = "users:read"
+	ScopeUsersWrite   = "users:write"
+	ScopeConsent      = "consent"
+
+	ScopePasswordChange = "password:change"
+)
+
+// DefaultScopes are granted to logins that do not name a client.
+var DefaultScopes = []string{
+	ScopeProfileRead,
+	ScopeProfileWrite,
+	ScopeUsersRead,
+	ScopeUsersWrite,
+	ScopeConsent,
+	ScopePasswordChange,
+}
+
+var RoleScopes = map[string][]string{
+	RoleCodeAdmin: {
+		ScopeProfileRead,
+		ScopeProfileWrite,
+		ScopeUsersRead,
+		ScopeUsersWrite,
+		ScopeConsent,
+		ScopePasswordChange,
+	},
+	RoleCodeCustomer: {
+		ScopeProfileRead,
+		ScopeProfileWrite,
+		ScopeUsersRead,
+		ScopeConsent,
+		ScopePasswordChange,
+	},
+}
@@ -0,0 +1,11 @@
+package constants
+
+const (
+	SuspensionCategoryNoShow     = "no_show"
+	SuspensionCategoryHarassment = "harassment"
+	SuspensionCategoryCheating   = "cheating"
+	SuspensionCategoryOther      = "other"
+
+	SuspensionActorSystem   = "system"
+	SuspensionReasonExpired = "suspension expired"
+)
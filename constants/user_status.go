@@ -0,0 +1,12 @@
+package constants
+
+const (
+	UserStatusActive      = "active"
+	UserStatusDeactivated = "deactivated"
+	UserStatusSuspended   = "suspended"
+	UserStatusDeleted     = "deleted"
+
+	UserStatusPendingDeletion = "pending_deletion"
+	UserStatusAnonymized      = "anonymized"
+	UserStatusPendingApproval = "pending_approval"
+)
@@ -0,0 +1,10 @@
+package constants
+
+const (
+	ImportFormatCSV    = "csv"
+	ImportFormatNDJSON = "ndjson"
+)
+
+var ExportColumns = []string{
+	"uuid", "name", "username", "email", "phone_number", "date_of_birth", "role", "status", "created_at", "updated_at",
+}
@@ -0,0 +1,24 @@
+package constants
+
+var ReservedUsernames = []string{
+	"admin", "administrator", "root", "system", "support", "help", "helpdesk",
+	"moderator", "mod", "staff", "official", "security", "billing", "api",
+	"info", "contact", "noreply", "null", "undefined", "anonymous",
+}
+
+var BlockedUsernameWords = []string{
+	"fuck", "shit", "bitch", "asshole", "bastard", "cunt", "whore", "nigger", "faggot",
+	"anjing", "bangsat", "bajingan", "kontol", "memek", "ngentot", "goblok", "tolol", "jancok",
+}
+
+const (
+	// AnonymizedUsernameFormat names an anonymized account after its id.
+	AnonymizedUsernameFormat = "deleted_%d"
+	UsernameActorSystem      = "system"
+)
+
+// LeetReplacer undoes common character substitutions before usernames and
+// passwords are compared against word lists.
+var LeetReplacer = map[rune]rune{
+	'0': 'o', '1': 'i', '3': 'e', '4': 'a', '5': 's', '7': 't', '8': 'b', '@': 'a', '$': 's', '!': 'i',
+}
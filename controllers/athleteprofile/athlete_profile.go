@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"net/http"
+	errWrap "user-service/common/error"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AthleteProfileController struct {
+	service services.IServiceRegistry
+}
+
+type IAthleteProfileController interface {
+	Get(*gin.Context)
+	Create(*gin.Context)
+	Update(*gin.Context)
+	Delete(*gin.Context)
+}
+
+func NewAthleteProfileController(service services.IServiceRegistry) IAthleteProfileController {
+	return &AthleteProfileController{
+		service: service,
+	}
+}
+
+func (a *AthleteProfileController) Get(ctx *gin.Context) {
+	profile, err := a.service.GetAthleteProfile().Get(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: profile,
+		Gin:  ctx,
+	})
+}
+
+func (a *AthleteProfileController) Create(ctx *gin.Context) {
+	request, ok := bindAthleteProfileRequest(ctx)
+	if !ok {
+		return
+	}
+
+	profile, err := a.service.GetAthleteProfile().Create(ctx, request, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: profile,
+		Gin:  ctx,
+	})
+}
+
+func (a *AthleteProfileController) Update(ctx *gin.Context) {
+	request, ok := bindAthleteProfileRequest(ctx)
+	if !ok {
+		return
+	}
+
+	profile, err := a.service.GetAthleteProfile().Update(ctx, request, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: profile,
+		Gin:  ctx,
+	})
+}
+
+func (a *AthleteProfileController) Delete(ctx *gin.Context) {
+	err := a.service.GetAthleteProfile().Delete(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
+
+func bindAthleteProfileRequest(ctx *gin.Context) (*dto.AthleteProfileRequest, bool) {
+	request := &dto.AthleteProfileRequest{}
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return nil, false
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return nil, false
+	}
+
+	return request, true
+}
@@ -0,0 +1,185 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	errWrap "user-service/common/error"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AttributeController struct {
+	service services.IServiceRegistry
+}
+
+type IAttributeController interface {
+	GetDefinitions(*gin.Context)
+	CreateDefinition(*gin.Context)
+	UpdateDefinition(*gin.Context)
+	DeleteDefinition(*gin.Context)
+	SaveValues(*gin.Context)
+}
+
+func NewAttributeController(service services.IServiceRegistry) IAttributeController {
+	return &AttributeController{
+		service: service,
+	}
+}
+
+func (a *AttributeController) GetDefinitions(ctx *gin.Context) {
+	definitions, err := a.service.GetAttribute().GetDefinitions(ctx)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: definitions,
+		Gin:  ctx,
+	})
+}
+
+func (a *AttributeController) CreateDefinition(ctx *gin.Context) {
+	request, ok := bindAttributeDefinitionRequest(ctx)
+	if !ok {
+		return
+	}
+
+	definition, err := a.service.GetAttribute().CreateDefinition(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: definition,
+		Gin:  ctx,
+	})
+}
+
+func (a *AttributeController) UpdateDefinition(ctx *gin.Context) {
+	request, ok := bindAttributeDefinitionRequest(ctx)
+	if !ok {
+		return
+	}
+
+	definition, err := a.service.GetAttribute().UpdateDefinition(ctx, request, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: definition,
+		Gin:  ctx,
+	})
+}
+
+func (a *AttributeController) DeleteDefinition(ctx *gin.Context) {
+	err := a.service.GetAttribute().DeleteDefinition(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
+
+func (a *AttributeController) SaveValues(ctx *gin.Context) {
+	request := map[string]any{}
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	attributes, err := a.service.GetAttribute().SaveValues(ctx, request, ctx.Param("uuid"))
+	if err != nil {
+		var fieldErrors validator.ValidationErrors
+		if errors.As(err, &fieldErrors) {
+			errMessage := http.StatusText(http.StatusUnprocessableEntity)
+			errResponse := errWrap.ErrValidationResponse(err)
+			response.HttpResponse(response.ParamHttpResponse{
+				Code:    http.StatusUnprocessableEntity,
+				Message: &errMessage,
+				Data:    errResponse,
+				Error:   err,
+				Gin:     ctx,
+			})
+			return
+		}
+
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: attributes,
+		Gin:  ctx,
+	})
+}
+
+func bindAttributeDefinitionRequest(ctx *gin.Context) (*dto.AttributeDefinitionRequest, bool) {
+	request := &dto.AttributeDefinitionRequest{}
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return nil, false
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return nil, false
+	}
+
+	return request, true
+}
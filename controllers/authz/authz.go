@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"net/http"
+	errWrap "user-service/common/error"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AuthzController struct {
+	service services.IServiceRegistry
+}
+
+type IAuthzController interface {
+	Check(*gin.Context)
+	BatchCheck(*gin.Context)
+}
+
+func NewAuthzController(service services.IServiceRegistry) IAuthzController {
+	return &AuthzController{
+		service: service,
+	}
+}
+
+func (a *AuthzController) Check(ctx *gin.Context) {
+	request := &dto.AuthzCheckRequest{}
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	decision, err := a.service.GetAuthz().Check(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: decision,
+		Gin:  ctx,
+	})
+}
+
+func (a *AuthzController) BatchCheck(ctx *gin.Context) {
+	request := &dto.AuthzBatchCheckRequest{}
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	decisions, err := a.service.GetAuthz().BatchCheck(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: decisions,
+		Gin:  ctx,
+	})
+}
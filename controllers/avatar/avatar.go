@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"net/http"
+	"user-service/common/response"
+	"user-service/config"
+	errConstant "user-service/constants/error"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+const defaultMaxUploadByte = 5 << 20
+
+type AvatarController struct {
+	service services.IServiceRegistry
+}
+
+type IAvatarController interface {
+	Upload(*gin.Context)
+	Delete(*gin.Context)
+}
+
+func NewAvatarController(service services.IServiceRegistry) IAvatarController {
+	return &AvatarController{
+		service: service,
+	}
+}
+
+func (a *AvatarController) Upload(ctx *gin.Context) {
+	maxUploadByte := config.Config.Avatar.MaxUploadByte
+	if maxUploadByte <= 0 {
+		maxUploadByte = defaultMaxUploadByte
+	}
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxUploadByte+1<<20)
+
+	fileHeader, err := ctx.FormFile("avatar")
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: errConstant.ErrAvatarRequired,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	if fileHeader.Size > maxUploadByte {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusRequestEntityTooLarge,
+			Error: errConstant.ErrAvatarTooLarge,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+	defer file.Close()
+
+	avatar, err := a.service.GetAvatar().Upload(ctx, file)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: avatar,
+		Gin:  ctx,
+	})
+}
+
+func (a *AvatarController) Delete(ctx *gin.Context) {
+	err := a.service.GetAvatar().Delete(ctx)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
@@ -0,0 +1,181 @@
+package controllers
+
+import (
+	"net/http"
+	errWrap "user-service/common/error"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ConsentController struct {
+	service services.IServiceRegistry
+}
+
+type IConsentController interface {
+	GetCurrent(*gin.Context)
+	ListDocuments(*gin.Context)
+	Publish(*gin.Context)
+	GetMine(*gin.Context)
+	Accept(*gin.Context)
+	Report(*gin.Context)
+}
+
+func NewConsentController(service services.IServiceRegistry) IConsentController {
+	return &ConsentController{
+		service: service,
+	}
+}
+
+func (c *ConsentController) GetCurrent(ctx *gin.Context) {
+	documents, err := c.service.GetConsent().GetCurrent(ctx)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: documents,
+		Gin:  ctx,
+	})
+}
+
+func (c *ConsentController) ListDocuments(ctx *gin.Context) {
+	documents, err := c.service.GetConsent().ListDocuments(ctx)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: documents,
+		Gin:  ctx,
+	})
+}
+
+func (c *ConsentController) Publish(ctx *gin.Context) {
+	request := &dto.ConsentDocumentRequest{}
+	if !bindConsentRequest(ctx, request) {
+		return
+	}
+
+	document, err := c.service.GetConsent().Publish(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: document,
+		Gin:  ctx,
+	})
+}
+
+func (c *ConsentController) GetMine(ctx *gin.Context) {
+	documents, err := c.service.GetConsent().GetMine(ctx)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: documents,
+		Gin:  ctx,
+	})
+}
+
+func (c *ConsentController) Accept(ctx *gin.Context) {
+	request := &dto.ConsentAcceptRequest{}
+	if !bindConsentRequest(ctx, request) {
+		return
+	}
+
+	documents, err := c.service.GetConsent().Accept(ctx, request, &dto.RequestMeta{
+		IPAddress: ctx.ClientIP(),
+		UserAgent: ctx.Request.UserAgent(),
+	})
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: documents,
+		Gin:  ctx,
+	})
+}
+
+func (c *ConsentController) Report(ctx *gin.Context) {
+	report, err := c.service.GetConsent().Report(ctx)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: report,
+		Gin:  ctx,
+	})
+}
+
+func bindConsentRequest(ctx *gin.Context, request any) bool {
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return false
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	errWrap "user-service/common/error"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type DataExportController struct {
+	service services.IServiceRegistry
+}
+
+type IDataExportController interface {
+	Request(*gin.Context)
+	GetByUUID(*gin.Context)
+	Download(*gin.Context)
+}
+
+func NewDataExportController(service services.IServiceRegistry) IDataExportController {
+	return &DataExportController{
+		service: service,
+	}
+}
+
+func (d *DataExportController) Request(ctx *gin.Context) {
+	export, err := d.service.GetDataExport().Request(ctx)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusAccepted,
+		Data: export,
+		Gin:  ctx,
+	})
+}
+
+func (d *DataExportController) GetByUUID(ctx *gin.Context) {
+	export, err := d.service.GetDataExport().GetByUUID(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: export,
+		Gin:  ctx,
+	})
+}
+
+func (d *DataExportController) Download(ctx *gin.Context) {
+	request := &dto.DataExportDownloadRequest{}
+	err := ctx.ShouldBindQuery(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	filePath, err := d.service.GetDataExport().Download(ctx, ctx.Param("uuid"), request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusForbidden,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	ctx.FileAttachment(filePath, fmt.Sprintf("data-export-%s.zip", ctx.Param("uuid")))
+}
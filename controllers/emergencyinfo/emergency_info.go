@@ -0,0 +1,238 @@
+package controllers
+
+import (
+	"net/http"
+	errWrap "user-service/common/error"
+	"user-service/common/phone"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type EmergencyInfoController struct {
+	service services.IServiceRegistry
+}
+
+type IEmergencyInfoController interface {
+	Save(*gin.Context)
+	Get(*gin.Context)
+	GetByUUID(*gin.Context)
+	Delete(*gin.Context)
+	Grant(*gin.Context)
+	ListGrants(*gin.Context)
+	Revoke(*gin.Context)
+	ListAccessLogs(*gin.Context)
+}
+
+func NewEmergencyInfoController(service services.IServiceRegistry) IEmergencyInfoController {
+	return &EmergencyInfoController{
+		service: service,
+	}
+}
+
+func (e *EmergencyInfoController) Save(ctx *gin.Context) {
+	request := &dto.EmergencyInfoRequest{}
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+	for i := range request.Contacts {
+		request.Contacts[i].PhoneNumber = phone.Normalize(request.Contacts[i].PhoneNumber)
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	info, err := e.service.GetEmergencyInfo().Save(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: info,
+		Gin:  ctx,
+	})
+}
+
+func (e *EmergencyInfoController) Get(ctx *gin.Context) {
+	info, err := e.service.GetEmergencyInfo().Get(ctx, "", requestMeta(ctx))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: info,
+		Gin:  ctx,
+	})
+}
+
+func (e *EmergencyInfoController) GetByUUID(ctx *gin.Context) {
+	info, err := e.service.GetEmergencyInfo().Get(ctx, ctx.Param("uuid"), requestMeta(ctx))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: info,
+		Gin:  ctx,
+	})
+}
+
+func (e *EmergencyInfoController) Delete(ctx *gin.Context) {
+	err := e.service.GetEmergencyInfo().Delete(ctx)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
+
+func (e *EmergencyInfoController) Grant(ctx *gin.Context) {
+	request := &dto.EmergencyAccessGrantRequest{}
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	grant, err := e.service.GetEmergencyInfo().Grant(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: grant,
+		Gin:  ctx,
+	})
+}
+
+func (e *EmergencyInfoController) ListGrants(ctx *gin.Context) {
+	grants, err := e.service.GetEmergencyInfo().ListGrants(ctx)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: grants,
+		Gin:  ctx,
+	})
+}
+
+func (e *EmergencyInfoController) Revoke(ctx *gin.Context) {
+	err := e.service.GetEmergencyInfo().Revoke(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
+
+func (e *EmergencyInfoController) ListAccessLogs(ctx *gin.Context) {
+	logs, err := e.service.GetEmergencyInfo().ListAccessLogs(ctx)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: logs,
+		Gin:  ctx,
+	})
+}
+
+func requestMeta(ctx *gin.Context) *dto.RequestMeta {
+	return &dto.RequestMeta{
+		IPAddress: ctx.ClientIP(),
+		UserAgent: ctx.Request.UserAgent(),
+	}
+}
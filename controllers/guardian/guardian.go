@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	"net/http"
+	"user-service/common/response"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GuardianController struct {
+	service services.IServiceRegistry
+}
+
+type IGuardianController interface {
+	ListMinors(*gin.Context)
+	ListApprovals(*gin.Context)
+	Approve(*gin.Context)
+	Reject(*gin.Context)
+}
+
+func NewGuardianController(service services.IServiceRegistry) IGuardianController {
+	return &GuardianController{
+		service: service,
+	}
+}
+
+func (g *GuardianController) ListMinors(ctx *gin.Context) {
+	minors, err := g.service.GetGuardian().ListMinors(ctx)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: minors,
+		Gin:  ctx,
+	})
+}
+
+func (g *GuardianController) ListApprovals(ctx *gin.Context) {
+	approvals, err := g.service.GetGuardian().ListApprovals(ctx)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: approvals,
+		Gin:  ctx,
+	})
+}
+
+func (g *GuardianController) Approve(ctx *gin.Context) {
+	approval, err := g.service.GetGuardian().Approve(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: approval,
+		Gin:  ctx,
+	})
+}
+
+func (g *GuardianController) Reject(ctx *gin.Context) {
+	approval, err := g.service.GetGuardian().Reject(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: approval,
+		Gin:  ctx,
+	})
+}
@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"net/http"
+	errWrap "user-service/common/error"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type MembershipController struct {
+	service services.IServiceRegistry
+}
+
+type IMembershipController interface {
+	List(*gin.Context)
+	Grant(*gin.Context)
+	Revoke(*gin.Context)
+}
+
+func NewMembershipController(service services.IServiceRegistry) IMembershipController {
+	return &MembershipController{
+		service: service,
+	}
+}
+
+func (m *MembershipController) List(ctx *gin.Context) {
+	memberships, err := m.service.GetMembership().List(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: memberships,
+		Gin:  ctx,
+	})
+}
+
+func (m *MembershipController) Grant(ctx *gin.Context) {
+	request := &dto.MembershipGrantRequest{}
+	if !bindMembershipRequest(ctx, request) {
+		return
+	}
+
+	membership, err := m.service.GetMembership().Grant(ctx, request, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: membership,
+		Gin:  ctx,
+	})
+}
+
+func (m *MembershipController) Revoke(ctx *gin.Context) {
+	request := &dto.MembershipRevokeRequest{}
+	if !bindMembershipRequest(ctx, request) {
+		return
+	}
+
+	err := m.service.GetMembership().Revoke(ctx, request, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
+
+func bindMembershipRequest(ctx *gin.Context, request any) bool {
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return false
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return false
+	}
+
+	return true
+}
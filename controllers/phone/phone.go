@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"net/http"
+	errWrap "user-service/common/error"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type PhoneController struct {
+	service services.IServiceRegistry
+}
+
+type IPhoneController interface {
+	RequestVerification(*gin.Context)
+	ConfirmVerification(*gin.Context)
+}
+
+func NewPhoneController(service services.IServiceRegistry) IPhoneController {
+	return &PhoneController{
+		service: service,
+	}
+}
+
+func (p *PhoneController) RequestVerification(ctx *gin.Context) {
+	verification, err := p.service.GetPhone().RequestVerification(ctx)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: verification,
+		Gin:  ctx,
+	})
+}
+
+func (p *PhoneController) ConfirmVerification(ctx *gin.Context) {
+	request := &dto.PhoneVerificationConfirmRequest{}
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	verified, err := p.service.GetPhone().ConfirmVerification(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: verified,
+		Gin:  ctx,
+	})
+}
@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"net/http"
+	"user-service/common/response"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PreferenceController struct {
+	service services.IServiceRegistry
+}
+
+type IPreferenceController interface {
+	GetSchema(*gin.Context)
+	Get(*gin.Context)
+	GetByUUID(*gin.Context)
+	Update(*gin.Context)
+}
+
+func NewPreferenceController(service services.IServiceRegistry) IPreferenceController {
+	return &PreferenceController{
+		service: service,
+	}
+}
+
+func (p *PreferenceController) GetSchema(ctx *gin.Context) {
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: p.service.GetPreference().GetSchema(),
+		Gin:  ctx,
+	})
+}
+
+func (p *PreferenceController) Get(ctx *gin.Context) {
+	preference, err := p.service.GetPreference().Get(ctx)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: preference,
+		Gin:  ctx,
+	})
+}
+
+func (p *PreferenceController) GetByUUID(ctx *gin.Context) {
+	preference, err := p.service.GetPreference().GetByUUID(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: preference,
+		Gin:  ctx,
+	})
+}
+
+func (p *PreferenceController) Update(ctx *gin.Context) {
+	request := map[string]any{}
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	preference, err := p.service.GetPreference().Update(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: preference,
+		Gin:  ctx,
+	})
+}
@@ -1,7 +1,22 @@
 package controllers
 
 import (
-	controllers "user-service/controllers/user"
+	athleteProfileControllers "user-service/controllers/athleteprofile"
+	attributeControllers "user-service/controllers/attribute"
+	authzControllers "user-service/controllers/authz"
+	avatarControllers "user-service/controllers/avatar"
+	consentControllers "user-service/controllers/consent"
+	dataExportControllers "user-service/controllers/dataexport"
+	emergencyInfoControllers "user-service/controllers/emergencyinfo"
+	guardianControllers "user-service/controllers/guardian"
+	membershipControllers "user-service/controllers/membership"
+	phoneControllers "user-service/controllers/phone"
+	preferenceControllers "user-service/controllers/preference"
+	suspensionControllers "user-service/controllers/suspension"
+	userControllers "user-service/controllers/user"
+	userExportControllers "user-service/controllers/userexport"
+	userImportControllers "user-service/controllers/userimport"
+	usernameControllers "user-service/controllers/username"
 	"user-service/services"
 )
 
@@ -10,13 +25,88 @@ type Registry struct {
 }
 
 type IControllerRegistry interface {
-	GetUserController() controllers.IUserController
+	GetUserController() userControllers.IUserController
+	GetAuthzController() authzControllers.IAuthzController
+	GetDataExportController() dataExportControllers.IDataExportController
+	GetUserImportController() userImportControllers.IUserImportController
+	GetUserExportController() userExportControllers.IUserExportController
+	GetAvatarController() avatarControllers.IAvatarController
+	GetAthleteProfileController() athleteProfileControllers.IAthleteProfileController
+	GetGuardianController() guardianControllers.IGuardianController
+	GetEmergencyInfoController() emergencyInfoControllers.IEmergencyInfoController
+	GetPreferenceController() preferenceControllers.IPreferenceController
+	GetAttributeController() attributeControllers.IAttributeController
+	GetMembershipController() membershipControllers.IMembershipController
+	GetConsentController() consentControllers.IConsentController
+	GetSuspensionController() suspensionControllers.ISuspensionController
+	GetUsernameController() usernameControllers.IUsernameController
+	GetPhoneController() phoneControllers.IPhoneController
 }
 
 func NewControllerRegistry(service services.IServiceRegistry) IControllerRegistry {
 	return &Registry{service: service}
 }
 
-func (r *Registry) GetUserController() controllers.IUserController {
-	return controllers.NewUserController(r.service)
+func (r *Registry) GetUserController() userControllers.IUserController {
+	return userControllers.NewUserController(r.service)
+}
+
+func (r *Registry) GetAuthzController() authzControllers.IAuthzController {
+	return authzControllers.NewAuthzController(r.service)
+}
+
+func (r *Registry) GetDataExportController() dataExportControllers.IDataExportController {
+	return dataExportControllers.NewDataExportController(r.service)
+}
+
+func (r *Registry) GetUserImportController() userImportControllers.IUserImportController {
+	return userImportControllers.NewUserImportController(r.service)
+}
+
+func (r *Registry) GetUserExportController() userExportControllers.IUserExportController {
+	return userExportControllers.NewUserExportController(r.service)
+}
+
+func (r *Registry) GetAvatarController() avatarControllers.IAvatarController {
+	return avatarControllers.NewAvatarController(r.service)
+}
+
+func (r *Registry) GetAthleteProfileController() athleteProfileControllers.IAthleteProfileController {
+	return athleteProfileControllers.NewAthleteProfileController(r.service)
+}
+
+func (r *Registry) GetGuardianController() guardianControllers.IGuardianController {
+	return guardianControllers.NewGuardianController(r.service)
+}
+
+func (r *Registry) GetEmergencyInfoController() emergencyInfoControllers.IEmergencyInfoController {
+	return emergencyInfoControllers.NewEmergencyInfoController(r.service)
+}
+
+func (r *Registry) GetPreferenceController() preferenceControllers.IPreferenceController {
+	return preferenceControllers.NewPreferenceController(r.service)
+}
+
+func (r *Registry) GetAttributeController() attributeControllers.IAttributeController {
+	return attributeControllers.NewAttributeController(r.service)
+}
+
+func (r *Registry) GetMembershipController() membershipControllers.IMembershipController {
+	return membershipControllers.NewMembershipController(r.service)
+}
+
+func (r *Registry) GetConsentController() consentControllers.IConsentController {
+	return consentControllers.NewConsentController(r.service)
+}
+
+func (r *Registry) GetSuspensionController() suspensionControllers.ISuspensionController {
+	return suspensionControllers.NewSuspensionController(r.service)
+}
+
+func (r *Registry) GetUsernameController() usernameControllers.IUsernameController {
+	return usernameControllers.NewUsernameController(r.service)
+}
+
+func (r *Registry) GetPhoneController() phoneControllers.IPhoneController {
+	return phoneControllers.NewPhoneController(r.service)
 }
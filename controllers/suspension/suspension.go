@@ -0,0 +1,121 @@
+package controllers
+
+import (
+	"net/http"
+	errWrap "user-service/common/error"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type SuspensionController struct {
+	service services.IServiceRegistry
+}
+
+type ISuspensionController interface {
+	List(*gin.Context)
+	Suspend(*gin.Context)
+	Lift(*gin.Context)
+}
+
+func NewSuspensionController(service services.IServiceRegistry) ISuspensionController {
+	return &SuspensionController{
+		service: service,
+	}
+}
+
+func (s *SuspensionController) List(ctx *gin.Context) {
+	suspensions, err := s.service.GetSuspension().List(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: suspensions,
+		Gin:  ctx,
+	})
+}
+
+func (s *SuspensionController) Suspend(ctx *gin.Context) {
+	request := &dto.SuspensionRequest{}
+	if !bindSuspensionRequest(ctx, request) {
+		return
+	}
+
+	suspension, err := s.service.GetSuspension().Suspend(ctx, request, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: suspension,
+		Gin:  ctx,
+	})
+}
+
+func (s *SuspensionController) Lift(ctx *gin.Context) {
+	request := &dto.SuspensionLiftRequest{}
+	if !bindSuspensionRequest(ctx, request) {
+		return
+	}
+
+	suspension, err := s.service.GetSuspension().Lift(ctx, request, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: suspension,
+		Gin:  ctx,
+	})
+}
+
+func bindSuspensionRequest(ctx *gin.Context, request any) bool {
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return false
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return false
+	}
+
+	return true
+}
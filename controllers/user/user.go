@@ -1,8 +1,10 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 	errWrap "user-service/common/error"
+	"user-service/common/phone"
 	"user-service/common/response"
 	"user-service/domain/dto"
 	"user-service/services"
@@ -22,6 +24,14 @@ type IUserController interface {
 	UpdatePassword(*gin.Context)
 	GetUserLogin(*gin.Context)
 	GetUserByUUID(*gin.Context)
+	GetUsersByUUIDs(*gin.Context)
+	ListUsers(*gin.Context)
+	SearchUsers(*gin.Context)
+	DeleteUser(*gin.Context)
+	RestoreUser(*gin.Context)
+	DeactivateUser(*gin.Context)
+	ReactivateUser(*gin.Context)
+	DeleteAccount(*gin.Context)
 }
 
 func NewUserController(service services.IServiceRegistry) IUserController {
@@ -86,6 +96,7 @@ func (u *UserController) Register(ctx *gin.Context) {
 		})
 		return
 	}
+	request.PhoneNumber = phone.Normalize(request.PhoneNumber)
 
 	validate := validator.New()
 	err = validate.Struct(request)
@@ -104,6 +115,20 @@ func (u *UserController) Register(ctx *gin.Context) {
 
 	user, err := u.service.GetUser().Register(ctx, request)
 	if err != nil {
+		var fieldErrors validator.ValidationErrors
+		if errors.As(err, &fieldErrors) {
+			errMessage := http.StatusText(http.StatusUnprocessableEntity)
+			errResponse := errWrap.ErrValidationResponse(err)
+			response.HttpResponse(response.ParamHttpResponse{
+				Code:    http.StatusUnprocessableEntity,
+				Message: &errMessage,
+				Data:    errResponse,
+				Error:   err,
+				Gin:     ctx,
+			})
+			return
+		}
+
 		response.HttpResponse(response.ParamHttpResponse{
 			Code:  http.StatusBadRequest,
 			Error: err,
@@ -131,6 +156,7 @@ func (u *UserController) Update(ctx *gin.Context) {
 		})
 		return
 	}
+	request.PhoneNumber = phone.Normalize(request.PhoneNumber)
 
 	validate := validator.New()
 	err = validate.Struct(request)
@@ -194,6 +220,20 @@ func (u *UserController) UpdatePassword(ctx *gin.Context) {
 
 	user, err := u.service.GetUser().UpdatePassword(ctx, request, uuid)
 	if err != nil {
+		var fieldErrors validator.ValidationErrors
+		if errors.As(err, &fieldErrors) {
+			errMessage := http.StatusText(http.StatusUnprocessableEntity)
+			errResponse := errWrap.ErrValidationResponse(err)
+			response.HttpResponse(response.ParamHttpResponse{
+				Code:    http.StatusUnprocessableEntity,
+				Message: &errMessage,
+				Data:    errResponse,
+				Error:   err,
+				Gin:     ctx,
+			})
+			return
+		}
+
 		response.HttpResponse(response.ParamHttpResponse{
 			Code:  http.StatusBadRequest,
 			Error: err,
@@ -228,7 +268,143 @@ func (u *UserController) GetUserLogin(ctx *gin.Context) {
 }
 
 func (u *UserController) GetUserByUUID(ctx *gin.Context) {
-	user, err := u.service.GetUser().GetUserByUUID(ctx.Request.Context(), ctx.Param("uuid"))
+	request := &dto.UserDetailRequest{}
+	err := ctx.ShouldBindQuery(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	user, err := u.service.GetUser().GetUserByUUID(ctx.Request.Context(), request, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: user,
+		Gin:  ctx,
+	})
+}
+
+func (u *UserController) ListUsers(ctx *gin.Context) {
+	request := &dto.UserFilterRequest{}
+	err := ctx.ShouldBindQuery(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	users, err := u.service.GetUser().ListUsers(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code:       http.StatusOK,
+		Data:       users.Users,
+		Pagination: &users.Pagination,
+		Gin:        ctx,
+	})
+}
+
+func (u *UserController) SearchUsers(ctx *gin.Context) {
+	request := &dto.UserSearchRequest{}
+	err := ctx.ShouldBindQuery(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	users, err := u.service.GetUser().SearchUsers(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code:       http.StatusOK,
+		Data:       users.Results,
+		Pagination: &users.Pagination,
+		Gin:        ctx,
+	})
+}
+
+func (u *UserController) DeleteUser(ctx *gin.Context) {
+	err := u.service.GetUser().DeleteUser(ctx.Request.Context(), ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
+
+func (u *UserController) RestoreUser(ctx *gin.Context) {
+	user, err := u.service.GetUser().RestoreUser(ctx.Request.Context(), ctx.Param("uuid"))
 	if err != nil {
 		response.HttpResponse(response.ParamHttpResponse{
 			Code:  http.StatusBadRequest,
@@ -244,3 +420,127 @@ func (u *UserController) GetUserByUUID(ctx *gin.Context) {
 		Gin:  ctx,
 	})
 }
+
+func (u *UserController) DeactivateUser(ctx *gin.Context) {
+	user, err := u.service.GetUser().DeactivateUser(ctx.Request.Context(), ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: user,
+		Gin:  ctx,
+	})
+}
+
+func (u *UserController) ReactivateUser(ctx *gin.Context) {
+	user, err := u.service.GetUser().ReactivateUser(ctx.Request.Context(), ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: user,
+		Gin:  ctx,
+	})
+}
+
+func (u *UserController) DeleteAccount(ctx *gin.Context) {
+	request := &dto.DeleteAccountRequest{}
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	deletion, err := u.service.GetUser().DeleteAccount(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: deletion,
+		Gin:  ctx,
+	})
+}
+
+func (u *UserController) GetUsersByUUIDs(ctx *gin.Context) {
+	request := &dto.UserBatchRequest{}
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	users, err := u.service.GetUser().GetUsersByUUIDs(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: users,
+		Gin:  ctx,
+	})
+}
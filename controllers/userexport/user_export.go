@@ -0,0 +1,83 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+	errWrap "user-service/common/error"
+	"user-service/common/response"
+	"user-service/constants"
+	"user-service/domain/dto"
+	"user-service/services"
+	userExportServices "user-service/services/userexport"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+)
+
+type UserExportController struct {
+	service services.IServiceRegistry
+}
+
+type IUserExportController interface {
+	Export(*gin.Context)
+}
+
+func NewUserExportController(service services.IServiceRegistry) IUserExportController {
+	return &UserExportController{
+		service: service,
+	}
+}
+
+func (u *UserExportController) Export(ctx *gin.Context) {
+	request := &dto.UserExportRequest{}
+	err := ctx.ShouldBindQuery(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	_, err = userExportServices.ParseColumns(request.Columns)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	contentType, extension := "text/csv", constants.ImportFormatCSV
+	if request.Format == constants.ImportFormatNDJSON {
+		contentType, extension = "application/x-ndjson", constants.ImportFormatNDJSON
+	}
+
+	ctx.Header("Content-Type", contentType)
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="users-%s.%s"`, time.Now().Format("20060102150405"), extension))
+	ctx.Status(http.StatusOK)
+
+	err = u.service.GetUserExport().Export(ctx.Request.Context(), ctx.Writer, request)
+	if err != nil {
+		logrus.Errorf("failed to stream user export: %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package controllers
+
+import (
+	"net/http"
+	errWrap "user-service/common/error"
+	"user-service/common/response"
+	"user-service/config"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/services"
+	userImportServices "user-service/services/userimport"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+const defaultMaxUploadByte = 10 << 20
+
+type UserImportController struct {
+	service services.IServiceRegistry
+}
+
+type IUserImportController interface {
+	Import(*gin.Context)
+}
+
+func NewUserImportController(service services.IServiceRegistry) IUserImportController {
+	return &UserImportController{
+		service: service,
+	}
+}
+
+func (u *UserImportController) Import(ctx *gin.Context) {
+	request := &dto.UserImportRequest{}
+	err := ctx.ShouldBindQuery(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	maxUploadByte := config.Config.UserImport.MaxUploadByte
+	if maxUploadByte <= 0 {
+		maxUploadByte = defaultMaxUploadByte
+	}
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxUploadByte)
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: errConstant.ErrImportFileRequired,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	if request.Format == "" {
+		request.Format = userImportServices.FormatFromFilename(fileHeader.Filename)
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+	defer file.Close()
+
+	result, err := u.service.GetUserImport().Import(ctx, file, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	code := http.StatusOK
+	if result.Invalid > 0 {
+		code = http.StatusUnprocessableEntity
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: code,
+		Data: result,
+		Gin:  ctx,
+	})
+}
@@ -0,0 +1,41 @@
+package controllers
+
+import (
+	"net/http"
+	"user-service/common/response"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UsernameController struct {
+	service services.IServiceRegistry
+}
+
+type IUsernameController interface {
+	History(*gin.Context)
+}
+
+func NewUsernameController(service services.IServiceRegistry) IUsernameController {
+	return &UsernameController{
+		service: service,
+	}
+}
+
+func (u *UsernameController) History(ctx *gin.Context) {
+	histories, err := u.service.GetUsername().History(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHttpResponse{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHttpResponse{
+		Code: http.StatusOK,
+		Data: histories,
+		Gin:  ctx,
+	})
+}
@@ -0,0 +1,46 @@
+package migration
+
+import (
+	"user-service/common/phone"
+	"user-service/domain/models"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// PhoneNumberMigration rewrites every stored phone number that is not strict
+// E.164, including international numbers kept with separators, to E.164.
+// Numbers that cannot be parsed are left untouched and logged.
+func PhoneNumberMigration(db gorm.DB) {
+	var users []models.User
+	err := db.Unscoped().Select("id", "phone_number").
+		Where("phone_number <> '' AND phone_number !~ '^\\+[1-9][0-9]{1,14}$'").
+		Find(&users).Error
+	if err != nil {
+		logrus.Errorf("failed to load phone numbers: %v", err)
+		return
+	}
+
+	migrated := 0
+	for _, user := range users {
+		normalized, err := phone.Parse(user.PhoneNumber)
+		if err != nil {
+			logrus.Warnf("phone number of user %d cannot be normalized: %v", user.ID, err)
+			continue
+		}
+		if normalized == user.PhoneNumber {
+			continue
+		}
+
+		err = db.Unscoped().Model(&models.User{}).Where("id = ?", user.ID).Update("phone_number", normalized).Error
+		if err != nil {
+			logrus.Errorf("failed to normalize phone number of user %d: %v", user.ID, err)
+			continue
+		}
+		migrated++
+	}
+
+	if migrated > 0 {
+		logrus.Infof("%d phone numbers successfuly normalized", migrated)
+	}
+}
@@ -0,0 +1,20 @@
+package migration
+
+import "gorm.io/gorm"
+
+type Registry struct {
+	db *gorm.DB
+}
+
+type IMigrationRegistry interface {
+	Run()
+}
+
+func NewMigrationRegistry(db *gorm.DB) IMigrationRegistry {
+	return &Registry{db: db}
+}
+
+func (m *Registry) Run() {
+	SearchIndexMigration(*m.db)
+	PhoneNumberMigration(*m.db)
+}
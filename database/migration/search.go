@@ -0,0 +1,29 @@
+package migration
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var trigramColumns = []string{"name", "username", "email", "phone_number"}
+
+func SearchIndexMigration(db gorm.DB) {
+	err := db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error
+	if err != nil {
+		logrus.Warnf("pg_trgm extension is not available, user search falls back to ILIKE: %v", err)
+		return
+	}
+
+	for _, column := range trigramColumns {
+		index := fmt.Sprintf("idx_users_%s_trgm", column)
+		err = db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON users USING gin (%s gin_trgm_ops)", index, column)).Error
+		if err != nil {
+			logrus.Errorf("failed to create index %s: %v", index, err)
+			continue
+		}
+
+		logrus.Infof("index %s successfuly migrated", index)
+	}
+}
@@ -0,0 +1,32 @@
+package seeder
+
+import (
+	"encoding/json"
+	"user-service/common/policy"
+	"user-service/domain/models"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+func PolicySeeder(db gorm.DB) {
+	document := policy.DefaultDocument()
+	content, err := json.Marshal(document)
+	if err != nil {
+		logrus.Errorf("failed to marshal policy: %v", err)
+		panic(err)
+	}
+
+	policies := models.Policy{
+		Version:  document.Version,
+		Document: string(content),
+	}
+
+	err = db.FirstOrCreate(&policies, models.Policy{Version: policies.Version}).Error
+	if err != nil {
+		logrus.Errorf("failed to seed policy: %v", err)
+		panic(err)
+	}
+
+	logrus.Infof("policy version %d successfuly seeded", policies.Version)
+}
@@ -17,4 +17,5 @@ func NewSeederRegistry(db *gorm.DB) ISeedRegistry {
 func (s *Registry) Run() {
 	RoleSeeder(*s.db)
 	UserSeeder(*s.db)
+	PolicySeeder(*s.db)
 }
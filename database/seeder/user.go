@@ -1,28 +1,33 @@
 package seeder
 
 import (
+	"user-service/common/password"
 	"user-service/constants"
 	"user-service/domain/models"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
-	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
 func UserSeeder(db gorm.DB) {
-	password, _ := bcrypt.GenerateFromPassword([]byte("P@ssw0rd123"), bcrypt.DefaultCost)
+	hashedPassword, err := password.Hash("P@ssw0rd123")
+	if err != nil {
+		logrus.Errorf("failed to hash seeded password: %v", err)
+		panic(err)
+	}
+
 	users := models.User{
 		UUID:        uuid.New(),
 		Name:        "Admin",
 		Username:    "admin",
-		Password:    string(password),
+		Password:    hashedPassword,
 		Email:       "admin@gmail.com",
-		PhoneNumber: "0812131",
+		PhoneNumber: "+62812131",
 		RoleId:      constants.Admin,
 	}
 
-	err := db.FirstOrCreate(&users, models.User{Username: users.Username}).Error
+	err = db.FirstOrCreate(&users, models.User{Username: users.Username}).Error
 	if err != nil {
 		logrus.Errorf("failed to seed user: %v", err)
 		panic(err)
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AthleteSportRequest struct {
+	Sport      string   `json:"sport" validate:"required,max=50"`
+	SkillLevel string   `json:"skill_level" validate:"required,oneof=beginner intermediate advanced professional"`
+	Verified   bool     `json:"verified"`
+	Positions  []string `json:"positions" validate:"omitempty,max=5,dive,required,max=50"`
+}
+
+type AthleteProfileRequest struct {
+	Sports       []AthleteSportRequest `json:"sports" validate:"required,min=1,max=20,dive"`
+	DominantHand string                `json:"dominant_hand" validate:"omitempty,oneof=left right both"`
+	DominantFoot string                `json:"dominant_foot" validate:"omitempty,oneof=left right both"`
+	HeightCm     *float64              `json:"height_cm" validate:"omitempty,min=50,max=260"`
+	WeightKg     *float64              `json:"weight_kg" validate:"omitempty,min=20,max=300"`
+}
+
+type AthleteSportResponse struct {
+	Sport       string   `json:"sport"`
+	SkillLevel  string   `json:"skill_level"`
+	SkillSource string   `json:"skill_source"`
+	Positions   []string `json:"positions"`
+}
+
+type AthleteProfileResponse struct {
+	UUID         uuid.UUID              `json:"uuid"`
+	Sports       []AthleteSportResponse `json:"sports"`
+	DominantHand string                 `json:"dominant_hand,omitempty"`
+	DominantFoot string                 `json:"dominant_foot,omitempty"`
+	HeightCm     *float64               `json:"height_cm,omitempty"`
+	WeightKg     *float64               `json:"weight_kg,omitempty"`
+	UpdatedAt    *time.Time             `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,24 @@
+package dto
+
+import "github.com/google/uuid"
+
+type AttributeDefinitionRequest struct {
+	Key        string   `json:"key" validate:"required,max=50"`
+	Label      string   `json:"label" validate:"required,max=100"`
+	Type       string   `json:"type" validate:"required,oneof=string integer number boolean date enum"`
+	Required   bool     `json:"required"`
+	Visibility string   `json:"visibility" validate:"required,oneof=public private admin"`
+	Options    []string `json:"options" validate:"omitempty,max=100,dive,required,max=100"`
+	Rules      string   `json:"rules" validate:"omitempty,max=255"`
+}
+
+type AttributeDefinitionResponse struct {
+	UUID       uuid.UUID `json:"uuid"`
+	Key        string    `json:"key"`
+	Label      string    `json:"label"`
+	Type       string    `json:"type"`
+	Required   bool      `json:"required"`
+	Visibility string    `json:"visibility"`
+	Options    []string  `json:"options,omitempty"`
+	Rules      string    `json:"rules,omitempty"`
+}
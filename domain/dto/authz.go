@@ -0,0 +1,33 @@
+package dto
+
+type AuthzSubject struct {
+	UUID       string         `json:"uuid"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+type AuthzResource struct {
+	Type       string         `json:"type" validate:"required"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+type AuthzCheckRequest struct {
+	Subject  AuthzSubject  `json:"subject"`
+	Action   string        `json:"action" validate:"required"`
+	Resource AuthzResource `json:"resource"`
+}
+
+type AuthzBatchCheckRequest struct {
+	Checks []AuthzCheckRequest `json:"checks" validate:"required,min=1,max=100,dive"`
+}
+
+type AuthzDecisionResponse struct {
+	Allowed  bool   `json:"allowed"`
+	Effect   string `json:"effect"`
+	Reason   string `json:"reason"`
+	PolicyID string `json:"policy_id,omitempty"`
+	Version  int    `json:"version"`
+}
+
+type AuthzBatchDecisionResponse struct {
+	Decisions []AuthzDecisionResponse `json:"decisions"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ConsentDocumentRequest struct {
+	Type        string     `json:"type" validate:"required,oneof=terms privacy"`
+	Version     string     `json:"version" validate:"required,max=20"`
+	Title       string     `json:"title" validate:"required,max=150"`
+	URL         string     `json:"url" validate:"required,url,max=255"`
+	PublishedAt *time.Time `json:"published_at"`
+}
+
+type ConsentAcceptRequest struct {
+	Documents []string `json:"documents" validate:"required,min=1,max=10,dive,uuid"`
+}
+
+type ConsentDocumentResponse struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	Type        string     `json:"type"`
+	Version     string     `json:"version"`
+	Title       string     `json:"title"`
+	URL         string     `json:"url"`
+	PublishedAt time.Time  `json:"published_at"`
+	AcceptedAt  *time.Time `json:"accepted_at,omitempty"`
+}
+
+type ConsentReportResponse struct {
+	Document       ConsentDocumentResponse `json:"document"`
+	Current        bool                    `json:"current"`
+	ActiveUsers    int64                   `json:"active_users"`
+	AcceptedUsers  int64                   `json:"accepted_users"`
+	AcceptanceRate float64                 `json:"acceptance_rate"`
+}
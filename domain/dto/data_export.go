@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DataExportResponse struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	Status      string     `json:"status"`
+	DownloadURL *string    `json:"download_url,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt   *time.Time `json:"created_at"`
+}
+
+type DataExportDownloadRequest struct {
+	Expires   int64  `form:"expires" validate:"required"`
+	Signature string `form:"signature" validate:"required"`
+}
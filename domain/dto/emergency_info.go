@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EmergencyContact struct {
+	Name         string `json:"name" validate:"required,max=100"`
+	Relationship string `json:"relationship" validate:"omitempty,max=50"`
+	PhoneNumber  string `json:"phone_number" validate:"required,e164"`
+	Email        string `json:"email" validate:"omitempty,email"`
+}
+
+type EmergencyInfoRequest struct {
+	Contacts    []EmergencyContact `json:"contacts" validate:"required,min=1,max=5,dive"`
+	Allergies   []string           `json:"allergies" validate:"omitempty,max=20,dive,required,max=100"`
+	Conditions  []string           `json:"conditions" validate:"omitempty,max=20,dive,required,max=100"`
+	Medications []string           `json:"medications" validate:"omitempty,max=20,dive,required,max=100"`
+	BloodType   string             `json:"blood_type" validate:"omitempty,oneof=A+ A- B+ B- AB+ AB- O+ O-"`
+	Notes       string             `json:"notes" validate:"omitempty,max=1000"`
+}
+
+type EmergencyInfoResponse struct {
+	Contacts    []EmergencyContact `json:"contacts"`
+	Allergies   []string           `json:"allergies"`
+	Conditions  []string           `json:"conditions"`
+	Medications []string           `json:"medications"`
+	BloodType   string             `json:"blood_type,omitempty"`
+	Notes       string             `json:"notes,omitempty"`
+	UpdatedAt   *time.Time         `json:"updated_at,omitempty"`
+}
+
+type EmergencyAccessGrantRequest struct {
+	GranteeUUID string     `json:"grantee_uuid" validate:"required,uuid"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+}
+
+type EmergencyAccessGrantResponse struct {
+	UUID      uuid.UUID    `json:"uuid"`
+	Grantee   UserResponse `json:"grantee"`
+	ExpiresAt *time.Time   `json:"expires_at,omitempty"`
+	RevokedAt *time.Time   `json:"revoked_at,omitempty"`
+	CreatedAt *time.Time   `json:"created_at,omitempty"`
+}
+
+type EmergencyAccessLogResponse struct {
+	Actor     UserResponse `json:"actor"`
+	Action    string       `json:"action"`
+	IPAddress string       `json:"ip_address,omitempty"`
+	UserAgent string       `json:"user_agent,omitempty"`
+	CreatedAt *time.Time   `json:"created_at,omitempty"`
+}
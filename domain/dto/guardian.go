@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GuardianApprovalResponse struct {
+	UUID      uuid.UUID      `json:"uuid"`
+	Type      string         `json:"type"`
+	Status    string         `json:"status"`
+	Minor     *UserResponse  `json:"minor,omitempty"`
+	Changes   map[string]any `json:"changes,omitempty"`
+	DecidedAt *time.Time     `json:"decided_at,omitempty"`
+	CreatedAt *time.Time     `json:"created_at,omitempty"`
+}
+
+type GuardianProfileChange struct {
+	Username    string `json:"username"`
+	Name        string `json:"name"`
+	Email       string `json:"email"`
+	PhoneNumber string `json:"phone_number"`
+}
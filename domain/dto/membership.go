@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MembershipGrantRequest struct {
+	Tier      string     `json:"tier" validate:"required,oneof=premium elite"`
+	StartsAt  *time.Time `json:"starts_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type MembershipRevokeRequest struct {
+	Reason string `json:"reason" validate:"required,max=255"`
+}
+
+type MembershipResponse struct {
+	UUID         uuid.UUID  `json:"uuid"`
+	Tier         string     `json:"tier"`
+	Status       string     `json:"status"`
+	Entitlements []string   `json:"entitlements"`
+	StartsAt     time.Time  `json:"starts_at"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	GrantedBy    string     `json:"granted_by"`
+	RevokedBy    string     `json:"revoked_by,omitempty"`
+	RevokeReason string     `json:"revoke_reason,omitempty"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+}
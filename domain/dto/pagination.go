@@ -0,0 +1,9 @@
+package dto
+
+type PaginationResponse struct {
+	Limit      int     `json:"limit"`
+	HasMore    bool    `json:"has_more"`
+	NextCursor *string `json:"next_cursor,omitempty"`
+	Page       int     `json:"page,omitempty"`
+	Total      *int64  `json:"total,omitempty"`
+}
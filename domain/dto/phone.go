@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+type PhoneVerificationResponse struct {
+	PhoneNumber string    `json:"phone_number"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	ResendAt    time.Time `json:"resend_at"`
+}
+
+type PhoneVerificationConfirmRequest struct {
+	Code string `json:"code" validate:"required,numeric,max=10"`
+}
+
+type PhoneVerifiedResponse struct {
+	PhoneNumber     string    `json:"phone_number"`
+	PhoneVerifiedAt time.Time `json:"phone_verified_at"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+type PreferenceDefinition struct {
+	Key         string   `json:"key"`
+	Type        string   `json:"type"`
+	Default     any      `json:"default"`
+	Enum        []string `json:"enum,omitempty"`
+	Format      string   `json:"format,omitempty"`
+	Min         *int     `json:"min,omitempty"`
+	Max         *int     `json:"max,omitempty"`
+	MaxLength   *int     `json:"max_length,omitempty"`
+	Description string   `json:"description"`
+}
+
+type PreferenceResponse struct {
+	Values    map[string]any `json:"values"`
+	UpdatedAt *time.Time     `json:"updated_at,omitempty"`
+}
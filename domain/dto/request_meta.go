@@ -0,0 +1,6 @@
+package dto
+
+type RequestMeta struct {
+	IPAddress string
+	UserAgent string
+}
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SuspensionRequest struct {
+	Category  string     `json:"category" validate:"required,oneof=no_show harassment cheating other"`
+	Reason    string     `json:"reason" validate:"required,max=255"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type SuspensionLiftRequest struct {
+	Reason string `json:"reason" validate:"required,max=255"`
+}
+
+type SuspensionResponse struct {
+	UUID       uuid.UUID  `json:"uuid"`
+	Category   string     `json:"category"`
+	Reason     string     `json:"reason"`
+	ImposedBy  string     `json:"imposed_by"`
+	Permanent  bool       `json:"permanent"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LiftedBy   string     `json:"lifted_by,omitempty"`
+	LiftReason string     `json:"lift_reason,omitempty"`
+	LiftedAt   *time.Time `json:"lifted_at,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+}
@@ -1,50 +1,146 @@
 package dto
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type LoginRequest struct {
-	Username string `json:"usernmae" validate:"required"`
-	Password string `json:"password" validate:"required"`
+	Username     string `json:"usernmae" validate:"required"`
+	Password     string `json:"password" validate:"required"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
 }
 
 type UserResponse struct {
-	UUID        uuid.UUID `json:"uuid"`
-	Name        string    `json:"name"`
-	Username    string    `json:"username"`
-	Email       string    `json:"email"`
-	Role        string    `json:"role"`
-	PhoneNumber string    `json:"phone_number"`
+	UUID            uuid.UUID                 `json:"uuid"`
+	Name            string                    `json:"name"`
+	Username        string                    `json:"username"`
+	Email           string                    `json:"email"`
+	Role            string                    `json:"role"`
+	PhoneNumber     string                    `json:"phone_number"`
+	PhoneVerifiedAt *time.Time                `json:"phone_verified_at,omitempty"`
+	Status          string                    `json:"status,omitempty"`
+	AvatarURL       *string                   `json:"avatar_url,omitempty"`
+	AvatarURLs      map[string]string         `json:"avatar_urls,omitempty"`
+	AthleteProfile  *AthleteProfileResponse   `json:"athlete_profile,omitempty"`
+	DateOfBirth     *string                   `json:"date_of_birth,omitempty"`
+	Minor           bool                      `json:"minor,omitempty"`
+	PendingChange   *GuardianApprovalResponse `json:"pending_change,omitempty"`
+	Attributes      map[string]any            `json:"attributes,omitempty"`
+	Membership      *MembershipResponse       `json:"membership,omitempty"`
+	CreatedAt       *time.Time                `json:"created_at,omitempty"`
+}
+
+type UserDetailRequest struct {
+	Include string `form:"include"`
 }
 
 type LoginResponse struct {
 	User  UserResponse
 	Token string `json:"token"`
+	// ConsentRequired is set when the token is limited to the consent scope
+	// until the pending documents are accepted.
+	ConsentRequired bool                      `json:"consent_required"`
+	PendingConsents []ConsentDocumentResponse `json:"pending_consents,omitempty"`
+	// PasswordResetRequired is set when the password was found in a breached
+	// password corpus and has to be changed.
+	PasswordResetRequired bool `json:"password_reset_required"`
 }
 
 type RegiterRequest struct {
 	Username        string `json:"usernmae" validate:"required"`
 	Name            string `json:"name" validate:"required"`
 	Email           string `json:"email" validate:"required,email"`
-	PhoneNumber     string `json:"phone_number"`
+	PhoneNumber     string `json:"phone_number" validate:"omitempty,e164"`
 	Password        string `json:"password" validate:"required"`
 	ConfirmPassword string `json:"confirm_password" validate:"required"`
+	DateOfBirth     string `json:"date_of_birth" validate:"omitempty,datetime=2006-01-02"`
+	GuardianEmail   string `json:"guardian_email" validate:"omitempty,email"`
 	RoleID          uint
+	Status          string `json:"-"`
 }
 
 type RegiterResponse struct {
-	User UserResponse `json:"user"`
+	User            UserResponse `json:"user"`
+	PendingApproval bool         `json:"pending_approval,omitempty"`
 }
 
 type UpdateRequest struct {
-	Username    string `json:"usernmae" validate:"required"`
-	Name        string `json:"name" validate:"required"`
-	Email       string `json:"email" validate:"required,email"`
-	PhoneNumber string `json:"phone_number"`
-	RoleID      uint
+	Username      string `json:"usernmae" validate:"required"`
+	Name          string `json:"name" validate:"required"`
+	Email         string `json:"email" validate:"required,email"`
+	PhoneNumber   string `json:"phone_number" validate:"omitempty,e164"`
+	DateOfBirth   string `json:"date_of_birth" validate:"omitempty,datetime=2006-01-02"`
+	GuardianEmail string `json:"guardian_email" validate:"omitempty,email"`
+	RoleID        uint
 }
 
 type UpdatePasswordRequest struct {
-	OldPassword     string `json:"old_password"`
-	NewPassword     string `json:"new_password"`
-	ConfirmPassword string `json:"confirm_password"`
+	OldPassword     string `json:"old_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required"`
+	ConfirmPassword string `json:"confirm_password" validate:"required"`
+}
+
+type UserFilterRequest struct {
+	Role        string `form:"role"`
+	Status      string `form:"status" validate:"omitempty,oneof=active deactivated suspended deleted pending_deletion anonymized pending_approval"`
+	CreatedFrom string `form:"created_from" validate:"omitempty,datetime=2006-01-02"`
+	CreatedTo   string `form:"created_to" validate:"omitempty,datetime=2006-01-02"`
+	EmailDomain string `form:"email_domain"`
+	Sort        string `form:"sort" validate:"omitempty,oneof=created_at -created_at name -name username -username email -email"`
+	Cursor      string `form:"cursor"`
+	Limit       int    `form:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type UserBatchRequest struct {
+	UUIDs []string `json:"uuids" validate:"required,min=1,max=1000,dive,uuid"`
+}
+
+type UserBatchResponse struct {
+	Users    []UserResponse `json:"users"`
+	NotFound []string       `json:"not_found"`
+}
+
+type UserListResponse struct {
+	Users      []UserResponse
+	Pagination PaginationResponse
+}
+
+type UserSearchRequest struct {
+	Query string `form:"q" validate:"required,min=2"`
+	Page  int    `form:"page" validate:"omitempty,min=1"`
+	Limit int    `form:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type UserSearchResult struct {
+	User       UserResponse      `json:"user"`
+	Score      float64           `json:"score"`
+	Highlights map[string]string `json:"highlights,omitempty"`
+}
+
+type UserSearchResponse struct {
+	Results    []UserSearchResult
+	Pagination PaginationResponse
+}
+
+type DeleteAccountRequest struct {
+	Password string `json:"password" validate:"required"`
+}
+
+type DeleteAccountResponse struct {
+	DeletionScheduledAt time.Time `json:"deletion_scheduled_at"`
+}
+
+type UserExportRequest struct {
+	UserFilterRequest
+	Format  string `form:"format" validate:"omitempty,oneof=csv ndjson"`
+	Columns string `form:"columns"`
+	MaskPII bool   `form:"mask_pii"`
+}
+
+type AvatarResponse struct {
+	AvatarURL  string            `json:"avatar_url"`
+	AvatarURLs map[string]string `json:"avatar_urls"`
 }
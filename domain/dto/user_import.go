@@ -0,0 +1,22 @@
+package dto
+
+import errWrap "user-service/common/error"
+
+type UserImportRequest struct {
+	Format string `form:"format" validate:"omitempty,oneof=csv ndjson"`
+	DryRun bool   `form:"dry_run"`
+}
+
+type UserImportRowError struct {
+	Row    int                          `json:"row"`
+	Errors []errWrap.ValidationResponse `json:"errors"`
+}
+
+type UserImportResponse struct {
+	DryRun   bool                 `json:"dry_run"`
+	Total    int                  `json:"total"`
+	Valid    int                  `json:"valid"`
+	Invalid  int                  `json:"invalid"`
+	Imported int                  `json:"imported"`
+	Errors   []UserImportRowError `json:"errors"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+import "time"
+
+type UsernameHistoryResponse struct {
+	Username    string    `json:"username"`
+	NewUsername string    `json:"new_username"`
+	ChangedBy   string    `json:"changed_by"`
+	HeldUntil   time.Time `json:"held_until"`
+	ChangedAt   time.Time `json:"changed_at"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AthleteProfile struct {
+	ID           uint           `gorm:"primaryKey;autoincrement"`
+	UUID         uuid.UUID      `gorm:"type:uuid;not null;uniqueIndex"`
+	UserID       uint           `gorm:"not null;uniqueIndex"`
+	Sports       []AthleteSport `gorm:"type:jsonb;serializer:json"`
+	DominantHand string         `gorm:"type:varchar(10)"`
+	DominantFoot string         `gorm:"type:varchar(10)"`
+	HeightCm     *float64       `gorm:"type:numeric(5,2)"`
+	WeightKg     *float64       `gorm:"type:numeric(5,2)"`
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+	User         User `gorm:"foreignKey:user_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+type AthleteSport struct {
+	Sport       string   `json:"sport"`
+	SkillLevel  string   `json:"skill_level"`
+	SkillSource string   `json:"skill_source"`
+	Positions   []string `json:"positions"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AttributeDefinition struct {
+	ID         uint      `gorm:"primaryKey;autoincrement"`
+	UUID       uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
+	Key        string    `gorm:"type:varchar(50);not null;uniqueIndex"`
+	Label      string    `gorm:"type:varchar(100);not null"`
+	Type       string    `gorm:"type:varchar(20);not null"`
+	Required   bool      `gorm:"not null;default:false"`
+	Visibility string    `gorm:"type:varchar(20);not null"`
+	Options    []string  `gorm:"type:jsonb;serializer:json"`
+	Rules      string    `gorm:"type:varchar(255)"`
+	CreatedAt  *time.Time
+	UpdatedAt  *time.Time
+}
+
+type UserAttributeValue struct {
+	ID           uint `gorm:"primaryKey;autoincrement"`
+	UserID       uint `gorm:"not null;uniqueIndex:idx_user_attribute_values_user_definition"`
+	DefinitionID uint `gorm:"not null;uniqueIndex:idx_user_attribute_values_user_definition;index"`
+	Value        any  `gorm:"type:jsonb;serializer:json"`
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+	User         User                `gorm:"foreignKey:user_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Definition   AttributeDefinition `gorm:"foreignKey:definition_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ConsentDocument struct {
+	ID          uint      `gorm:"primaryKey;autoincrement"`
+	UUID        uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
+	Type        string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_consent_documents_type_version"`
+	Version     string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_consent_documents_type_version"`
+	Title       string    `gorm:"type:varchar(150);not null"`
+	URL         string    `gorm:"type:varchar(255);not null"`
+	PublishedAt time.Time `gorm:"not null;index"`
+	CreatedAt   *time.Time
+}
+
+type ConsentAcceptance struct {
+	ID         uint            `gorm:"primaryKey;autoincrement"`
+	UserID     uint            `gorm:"not null;uniqueIndex:idx_consent_acceptances_user_document"`
+	DocumentID uint            `gorm:"not null;uniqueIndex:idx_consent_acceptances_user_document;index"`
+	IPAddress  string          `gorm:"type:varchar(45)"`
+	UserAgent  string          `gorm:"type:varchar(255)"`
+	AcceptedAt time.Time       `gorm:"not null"`
+	User       User            `gorm:"foreignKey:user_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Document   ConsentDocument `gorm:"foreignKey:document_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
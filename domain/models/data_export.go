@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DataExport struct {
+	ID          uint      `gorm:"primaryKey;autoincrement"`
+	UUID        uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
+	UserID      uint      `gorm:"not null;index"`
+	Status      string    `gorm:"type:varchar(20);not null;index"`
+	FilePath    string    `gorm:"type:varchar(255)"`
+	Error       string    `gorm:"type:varchar(255)"`
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	User        User `gorm:"foreignKey:user_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EmergencyInfo struct {
+	ID        uint   `gorm:"primaryKey;autoincrement"`
+	UserID    uint   `gorm:"not null;uniqueIndex"`
+	Data      string `gorm:"type:text;not null"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+	User      User `gorm:"foreignKey:user_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+type EmergencyAccessGrant struct {
+	ID        uint      `gorm:"primaryKey;autoincrement"`
+	UUID      uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
+	UserID    uint      `gorm:"not null;index"`
+	GranteeID uint      `gorm:"not null;index"`
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+	CreatedAt *time.Time
+	Grantee   User `gorm:"foreignKey:grantee_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+type EmergencyAccessLog struct {
+	ID        uint   `gorm:"primaryKey;autoincrement"`
+	UserID    uint   `gorm:"not null;index"`
+	ActorID   uint   `gorm:"not null;index"`
+	Action    string `gorm:"type:varchar(20);not null"`
+	IPAddress string `gorm:"type:varchar(45)"`
+	UserAgent string `gorm:"type:varchar(255)"`
+	CreatedAt *time.Time
+	Actor     User `gorm:"foreignKey:actor_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
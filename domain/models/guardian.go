@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GuardianLink struct {
+	ID         uint `gorm:"primaryKey;autoincrement"`
+	GuardianID uint `gorm:"not null;uniqueIndex:idx_guardian_links_guardian_minor"`
+	MinorID    uint `gorm:"not null;uniqueIndex:idx_guardian_links_guardian_minor;index"`
+	CreatedAt  *time.Time
+	Guardian   User `gorm:"foreignKey:guardian_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Minor      User `gorm:"foreignKey:minor_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+type GuardianApproval struct {
+	ID          uint      `gorm:"primaryKey;autoincrement"`
+	UUID        uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
+	MinorID     uint      `gorm:"not null;index"`
+	Type        string    `gorm:"type:varchar(30);not null"`
+	Payload     string    `gorm:"type:jsonb"`
+	Status      string    `gorm:"type:varchar(20);not null;index"`
+	DecidedByID *uint
+	DecidedAt   *time.Time
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	Minor       User `gorm:"foreignKey:minor_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
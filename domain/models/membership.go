@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Membership struct {
+	ID           uint      `gorm:"primaryKey;autoincrement"`
+	UUID         uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
+	UserID       uint      `gorm:"not null;index"`
+	Tier         string    `gorm:"type:varchar(20);not null"`
+	Status       string    `gorm:"type:varchar(20);not null;index"`
+	StartsAt     time.Time `gorm:"not null"`
+	ExpiresAt    *time.Time
+	GrantedBy    string `gorm:"type:varchar(50);not null"`
+	RevokedBy    string `gorm:"type:varchar(50)"`
+	RevokeReason string `gorm:"type:varchar(255)"`
+	RevokedAt    *time.Time
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+	User         User `gorm:"foreignKey:user_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
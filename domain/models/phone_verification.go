@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+type PhoneVerification struct {
+	ID          uint      `gorm:"primaryKey;autoincrement"`
+	UserID      uint      `gorm:"not null;uniqueIndex"`
+	PhoneNumber string    `gorm:"type:varchar(16);not null"`
+	CodeHash    string    `gorm:"type:varchar(64);not null"`
+	Attempts    int       `gorm:"not null;default:0"`
+	ExpiresAt   time.Time `gorm:"not null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	User        User `gorm:"foreignKey:user_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+type Policy struct {
+	ID        uint   `gorm:"primaryKey;autoincrement"`
+	Version   int    `gorm:"not null;uniqueIndex"`
+	Document  string `gorm:"type:jsonb;not null"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+type UserPreference struct {
+	ID        uint           `gorm:"primaryKey;autoincrement"`
+	UserID    uint           `gorm:"not null;uniqueIndex"`
+	Values    map[string]any `gorm:"type:jsonb;serializer:json"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+	User      User `gorm:"foreignKey:user_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Suspension struct {
+	ID         uint       `gorm:"primaryKey;autoincrement"`
+	UUID       uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex"`
+	UserID     uint       `gorm:"not null;index"`
+	Category   string     `gorm:"type:varchar(20);not null"`
+	Reason     string     `gorm:"type:varchar(255);not null"`
+	ImposedBy  string     `gorm:"type:varchar(50);not null"`
+	ExpiresAt  *time.Time `gorm:"index"`
+	LiftedBy   string     `gorm:"type:varchar(50)"`
+	LiftReason string     `gorm:"type:varchar(255)"`
+	LiftedAt   *time.Time
+	CreatedAt  *time.Time
+	User       User `gorm:"foreignKey:user_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -4,18 +4,27 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type User struct {
-	ID          uint      `gorm:"primaryKey;autoincrement"`
-	UUID        uuid.UUID `gorm:"type:uuid;not null"`
-	Name        string    `gorm:"type:varchar(100);not null"`
-	Username    string    `gorm:"type:varchar(20);not null"`
-	Password    string    `gorm:"type:varchar(255);not null"`
-	Email       string    `gorm:"type:varchar(100);not null"`
-	PhoneNumber string    `gorm:"type:varchar(15)"`
-	RoleId      uint      `gorm:"type:uint;not null"`
-	CreatedAt   *time.Time
-	UpdatedAt   *time.Time
-	Role        Role `gorm:"foreignKey:role_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	ID                  uint       `gorm:"primaryKey;autoincrement"`
+	UUID                uuid.UUID  `gorm:"type:uuid;not null"`
+	Name                string     `gorm:"type:varchar(100);not null"`
+	Username            string     `gorm:"type:varchar(20);not null"`
+	Password            string     `gorm:"type:varchar(255);not null"`
+	Email               string     `gorm:"type:varchar(100);not null"`
+	PhoneNumber         string     `gorm:"type:varchar(16)"`
+	AvatarPath          string     `gorm:"type:varchar(255)"`
+	DateOfBirth         *time.Time `gorm:"type:date"`
+	RoleId              uint       `gorm:"type:uint;not null"`
+	Status              string     `gorm:"type:varchar(20);not null;default:active;index"`
+	DeletionScheduledAt *time.Time `gorm:"index"`
+	PhoneVerifiedAt     *time.Time
+	MustChangePassword  bool `gorm:"not null;default:false"`
+	AnonymizedAt        *time.Time
+	CreatedAt           *time.Time
+	UpdatedAt           *time.Time
+	DeletedAt           gorm.DeletedAt `gorm:"index"`
+	Role                Role           `gorm:"foreignKey:role_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
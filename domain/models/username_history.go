@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+type UsernameHistory struct {
+	ID          uint      `gorm:"primaryKey;autoincrement"`
+	UserID      *uint     `gorm:"index"`
+	Username    string    `gorm:"type:varchar(20);not null;index"`
+	NewUsername string    `gorm:"type:varchar(20);not null"`
+	ChangedBy   string    `gorm:"type:varchar(50);not null"`
+	HeldUntil   time.Time `gorm:"not null"`
+	CreatedAt   time.Time `gorm:"not null;index"`
+	User        User      `gorm:"foreignKey:user_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
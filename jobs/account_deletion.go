@@ -0,0 +1,27 @@
+package jobs
+
+import (
+	"context"
+	"time"
+	"user-service/config"
+
+	"github.com/sirupsen/logrus"
+)
+
+func AccountDeletionInterval() time.Duration {
+	interval := config.Config.AccountDeletion.JobIntervalMinute
+	if interval <= 0 {
+		interval = 60
+	}
+
+	return time.Duration(interval) * time.Minute
+}
+
+func (r *Registry) accountDeletion(ctx context.Context) error {
+	count, err := r.service.GetUser().AnonymizeDueAccounts(ctx)
+	if count > 0 {
+		logrus.Infof("%d accounts successfuly anonymized", count)
+	}
+
+	return err
+}
@@ -0,0 +1,21 @@
+package jobs
+
+import (
+	"context"
+	"time"
+	"user-service/common/breach"
+	"user-service/config"
+)
+
+func BreachRefreshInterval() time.Duration {
+	interval := config.Config.BreachedPassword.RefreshIntervalMinute
+	if interval <= 0 {
+		interval = 60
+	}
+
+	return time.Duration(interval) * time.Minute
+}
+
+func (r *Registry) breachRefresh(ctx context.Context) error {
+	return breach.Default().Refresh(ctx)
+}
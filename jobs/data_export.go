@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+	"user-service/config"
+
+	"github.com/sirupsen/logrus"
+)
+
+func DataExportInterval() time.Duration {
+	interval := config.Config.DataExport.JobIntervalSecond
+	if interval <= 0 {
+		interval = 30
+	}
+
+	return time.Duration(interval) * time.Second
+}
+
+// dataExport purges expired archives even when building fails, so one broken
+// export cannot keep old archives on disk.
+func (r *Registry) dataExport(ctx context.Context) error {
+	count, processErr := r.service.GetDataExport().ProcessPending(ctx)
+	if count > 0 {
+		logrus.Infof("%d data exports successfuly built", count)
+	}
+
+	count, err := r.service.GetDataExport().PurgeExpired(ctx)
+	if count > 0 {
+		logrus.Infof("%d data exports successfuly purged", count)
+	}
+
+	return errors.Join(processErr, err)
+}
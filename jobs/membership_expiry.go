@@ -0,0 +1,27 @@
+package jobs
+
+import (
+	"context"
+	"time"
+	"user-service/config"
+
+	"github.com/sirupsen/logrus"
+)
+
+func MembershipExpiryInterval() time.Duration {
+	interval := config.Config.Membership.JobIntervalMinute
+	if interval <= 0 {
+		interval = 10
+	}
+
+	return time.Duration(interval) * time.Minute
+}
+
+func (r *Registry) membershipExpiry(ctx context.Context) error {
+	count, err := r.service.GetMembership().ExpireDue(ctx)
+	if count > 0 {
+		logrus.Infof("%d memberships successfuly expired", count)
+	}
+
+	return err
+}
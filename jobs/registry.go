@@ -0,0 +1,46 @@
+package jobs
+
+import (
+	"context"
+	"time"
+	"user-service/services"
+
+	"github.com/sirupsen/logrus"
+)
+
+type Registry struct {
+	service services.IServiceRegistry
+}
+
+type IJobRegistry interface {
+	Start(context.Context)
+}
+
+func NewJobRegistry(service services.IServiceRegistry) IJobRegistry {
+	return &Registry{service: service}
+}
+
+func (r *Registry) Start(ctx context.Context) {
+	go schedule(ctx, "account deletion", AccountDeletionInterval(), r.accountDeletion)
+	go schedule(ctx, "data export", DataExportInterval(), r.dataExport)
+	go schedule(ctx, "membership expiry", MembershipExpiryInterval(), r.membershipExpiry)
+	go schedule(ctx, "suspension lift", SuspensionLiftInterval(), r.suspensionLift)
+	go schedule(ctx, "breached password refresh", BreachRefreshInterval(), r.breachRefresh)
+}
+
+func schedule(ctx context.Context, name string, interval time.Duration, run func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		err := run(ctx)
+		if err != nil {
+			logrus.Errorf("job %s failed: %v", name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
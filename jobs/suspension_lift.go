@@ -0,0 +1,27 @@
+package jobs
+
+import (
+	"context"
+	"time"
+	"user-service/config"
+
+	"github.com/sirupsen/logrus"
+)
+
+func SuspensionLiftInterval() time.Duration {
+	interval := config.Config.Suspension.JobIntervalMinute
+	if interval <= 0 {
+		interval = 5
+	}
+
+	return time.Duration(interval) * time.Minute
+}
+
+func (r *Registry) suspensionLift(ctx context.Context) error {
+	count, err := r.service.GetSuspension().LiftExpired(ctx)
+	if count > 0 {
+		logrus.Infof("%d suspensions successfuly lifted", count)
+	}
+
+	return err
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"user-service/common/policy"
 	"user-service/common/response"
 	"user-service/config"
 	"user-service/constants"
+	"user-service/domain/dto"
 	services "user-service/services/user"
 
 	errConstants "user-service/constants/error"
@@ -21,6 +24,28 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+type AccountChecker interface {
+	CheckAccount(context.Context, string) error
+}
+
+var accountChecker AccountChecker
+
+// ResourceResolver loads the stored attributes of the resource a request
+// targets, so policies can refer to more than the URL parameters.
+type ResourceResolver interface {
+	ResourceAttributes(ctx context.Context, resourceType, uuid string) (policy.Attributes, error)
+}
+
+var resourceResolver ResourceResolver
+
+func SetResourceResolver(resolver ResourceResolver) {
+	resourceResolver = resolver
+}
+
+func SetAccountChecker(checker AccountChecker) {
+	accountChecker = checker
+}
+
 func HandlePanic() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		defer func() {
@@ -110,18 +135,32 @@ func validateBearerToken(c *gin.Context, token string) error {
 		return errConstants.ErrUnauthorized
 	}
 
-	type contextKey string
-	userLogin := c.Request.WithContext(context.WithValue(c.Request.Context(), contextKey(constants.UserLogin), claims.User))
+	userLogin := c.Request.WithContext(context.WithValue(c.Request.Context(), constants.UserLogin, claims.User))
 	c.Request = userLogin
+	c.Set(constants.UserLogin, claims.User)
+	c.Set(constants.Claims, claims)
 	c.Set(constants.Token, token)
 	return nil
 }
 
+func validateAccount(c *gin.Context) error {
+	if accountChecker == nil {
+		return nil
+	}
+
+	userLogin, ok := c.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok {
+		return errConstants.ErrUnauthorized
+	}
+
+	return accountChecker.CheckAccount(c.Request.Context(), userLogin.UUID.String())
+}
+
 func Authenticate() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var err error
 		token := c.GetHeader(constants.Authorization)
-		if token != "" {
+		if token == "" {
 			responseUnauthorized(c, errConstants.ErrUnauthorized.Error())
 			return
 		}
@@ -132,6 +171,16 @@ func Authenticate() gin.HandlerFunc {
 			return
 		}
 
+		err = validateAccount(c)
+		if err != nil {
+			message := errConstants.ErrUnauthorized.Error()
+			if errConstants.ErrMapping(err) && !errors.Is(err, errConstants.ErrNotFound) {
+				message = err.Error()
+			}
+			responseUnauthorized(c, message)
+			return
+		}
+
 		err = validateApiKey(c)
 		if err != nil {
 			responseUnauthorized(c, errConstants.ErrUnauthorized.Error())
@@ -141,3 +190,78 @@ func Authenticate() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+func responseForbidden(c *gin.Context, message string) {
+	c.JSON(http.StatusForbidden, response.Response{
+		Status:  constants.Error,
+		Message: message,
+	})
+	c.Abort()
+}
+
+func Authorize(action, resource string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		attributes := policy.Attributes{"type": resource}
+		for _, param := range c.Params {
+			attributes[param.Key] = param.Value
+		}
+
+		uuid := c.Param("uuid")
+		if resourceResolver != nil && uuid != "" {
+			resolved, err := resourceResolver.ResourceAttributes(c.Request.Context(), resource, uuid)
+			if err != nil && !errors.Is(err, errConstants.ErrNotFound) {
+				responseForbidden(c, errConstants.ErrForbidden.Error())
+				return
+			}
+			for key, value := range resolved {
+				if _, ok := attributes[key]; !ok {
+					attributes[key] = value
+				}
+			}
+		}
+
+		decision := policy.Evaluate(policy.Input{
+			Subject:  policy.SubjectFromContext(c),
+			Action:   action,
+			Resource: attributes,
+		})
+		if !decision.Allowed {
+			responseForbidden(c, errConstants.ErrForbidden.Error())
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func AuthenticateService() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		err := validateApiKey(c)
+		if err != nil {
+			responseUnauthorized(c, errConstants.ErrUnauthorized.Error())
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func RequireScope(scopes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, exists := c.Get(constants.Claims)
+		claims, ok := value.(*services.Claims)
+		if !exists || !ok {
+			responseUnauthorized(c, errConstants.ErrUnauthorized.Error())
+			return
+		}
+
+		for _, scope := range scopes {
+			if !claims.HasScope(scope) {
+				responseForbidden(c, errConstants.ErrInsufficientScope.Error())
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
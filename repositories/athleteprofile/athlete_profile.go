@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	wrapError "user-service/common/error"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type AthleteProfileRepository struct {
+	db *gorm.DB
+}
+
+type IAthleteProfileRepository interface {
+	FindByUserID(context.Context, uint) (*models.AthleteProfile, error)
+	Create(context.Context, *models.AthleteProfile) error
+	Update(context.Context, *models.AthleteProfile) error
+	DeleteByUserID(context.Context, uint) error
+}
+
+func NewAthleteProfileRepository(db *gorm.DB) IAthleteProfileRepository {
+	return &AthleteProfileRepository{db: db}
+}
+
+func (r *AthleteProfileRepository) FindByUserID(ctx context.Context, userID uint) (*models.AthleteProfile, error) {
+	var profile models.AthleteProfile
+
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&profile).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrAthleteProfileNotFound
+		}
+
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return &profile, nil
+}
+
+func (r *AthleteProfileRepository) Create(ctx context.Context, profile *models.AthleteProfile) error {
+	err := r.db.WithContext(ctx).Create(profile).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *AthleteProfileRepository) Update(ctx context.Context, profile *models.AthleteProfile) error {
+	err := r.db.WithContext(ctx).Model(profile).
+		Select("sports", "dominant_hand", "dominant_foot", "height_cm", "weight_kg").
+		Updates(profile).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *AthleteProfileRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&models.AthleteProfile{}).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
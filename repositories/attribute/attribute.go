@@ -0,0 +1,150 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	wrapError "user-service/common/error"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AttributeRepository struct {
+	db *gorm.DB
+}
+
+type IAttributeRepository interface {
+	FindAllDefinitions(context.Context) ([]models.AttributeDefinition, error)
+	FindDefinitionByUUID(context.Context, string) (*models.AttributeDefinition, error)
+	ExistsByKey(context.Context, string) (bool, error)
+	CreateDefinition(context.Context, *models.AttributeDefinition) error
+	UpdateDefinition(context.Context, *models.AttributeDefinition) error
+	DeleteDefinition(context.Context, *models.AttributeDefinition) error
+	FindValues(context.Context, uint) ([]models.UserAttributeValue, error)
+	SaveValues(context.Context, uint, []models.UserAttributeValue, []uint) error
+	DeleteValuesByUserID(context.Context, uint) error
+}
+
+func NewAttributeRepository(db *gorm.DB) IAttributeRepository {
+	return &AttributeRepository{db: db}
+}
+
+func (r *AttributeRepository) FindAllDefinitions(ctx context.Context) ([]models.AttributeDefinition, error) {
+	var definitions []models.AttributeDefinition
+
+	err := r.db.WithContext(ctx).Order("key asc").Find(&definitions).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return definitions, nil
+}
+
+func (r *AttributeRepository) FindDefinitionByUUID(ctx context.Context, uuid string) (*models.AttributeDefinition, error) {
+	var definition models.AttributeDefinition
+
+	err := r.db.WithContext(ctx).Where("uuid = ?", uuid).First(&definition).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrAttributeNotFound
+		}
+
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return &definition, nil
+}
+
+func (r *AttributeRepository) ExistsByKey(ctx context.Context, key string) (bool, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).Model(&models.AttributeDefinition{}).Where("key = ?", key).Count(&count).Error
+	if err != nil {
+		return false, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return count > 0, nil
+}
+
+func (r *AttributeRepository) CreateDefinition(ctx context.Context, definition *models.AttributeDefinition) error {
+	err := r.db.WithContext(ctx).Create(definition).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *AttributeRepository) UpdateDefinition(ctx context.Context, definition *models.AttributeDefinition) error {
+	err := r.db.WithContext(ctx).Save(definition).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *AttributeRepository) DeleteDefinition(ctx context.Context, definition *models.AttributeDefinition) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("definition_id = ?", definition.ID).Delete(&models.UserAttributeValue{}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Delete(definition).Error
+	})
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *AttributeRepository) FindValues(ctx context.Context, userID uint) ([]models.UserAttributeValue, error) {
+	var values []models.UserAttributeValue
+
+	err := r.db.WithContext(ctx).Preload("Definition").Where("user_id = ?", userID).Find(&values).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return values, nil
+}
+
+// SaveValues upserts values and removes the values of the removed definitions
+// in a single transaction.
+func (r *AttributeRepository) SaveValues(ctx context.Context, userID uint, values []models.UserAttributeValue, removed []uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(removed) > 0 {
+			err := tx.Where("user_id = ? AND definition_id IN ?", userID, removed).Delete(&models.UserAttributeValue{}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(values) == 0 {
+			return nil
+		}
+
+		return tx.Omit("User", "Definition").Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "definition_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at"}),
+		}).Create(&values).Error
+	})
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *AttributeRepository) DeleteValuesByUserID(ctx context.Context, userID uint) error {
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&models.UserAttributeValue{}).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
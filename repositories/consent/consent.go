@@ -0,0 +1,170 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	wrapError "user-service/common/error"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ConsentRepository struct {
+	db *gorm.DB
+}
+
+type IConsentRepository interface {
+	FindAll(context.Context) ([]models.ConsentDocument, error)
+	FindCurrent(context.Context, time.Time) ([]models.ConsentDocument, error)
+	FindByUUIDs(context.Context, []string) ([]models.ConsentDocument, error)
+	ExistsByVersion(context.Context, string, string) (bool, error)
+	Create(context.Context, *models.ConsentDocument) error
+	FindAcceptances(context.Context, uint) ([]models.ConsentAcceptance, error)
+	Accept(context.Context, []models.ConsentAcceptance) error
+	CountActiveUsers(context.Context) (int64, error)
+	CountAcceptances(context.Context) (map[uint]int64, error)
+	ScrubAcceptances(context.Context, uint) error
+}
+
+type acceptanceCount struct {
+	DocumentID uint
+	Total      int64
+}
+
+func NewConsentRepository(db *gorm.DB) IConsentRepository {
+	return &ConsentRepository{db: db}
+}
+
+func (r *ConsentRepository) FindAll(ctx context.Context) ([]models.ConsentDocument, error) {
+	var documents []models.ConsentDocument
+
+	err := r.db.WithContext(ctx).Order("published_at desc").Find(&documents).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return documents, nil
+}
+
+// FindCurrent returns the latest document of each type published at or
+// before now.
+func (r *ConsentRepository) FindCurrent(ctx context.Context, now time.Time) ([]models.ConsentDocument, error) {
+	var documents []models.ConsentDocument
+
+	err := r.db.WithContext(ctx).
+		Raw("SELECT DISTINCT ON (type) * FROM consent_documents WHERE published_at <= ? ORDER BY type, published_at DESC, id DESC", now).
+		Scan(&documents).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return documents, nil
+}
+
+func (r *ConsentRepository) FindByUUIDs(ctx context.Context, uuids []string) ([]models.ConsentDocument, error) {
+	var documents []models.ConsentDocument
+
+	err := r.db.WithContext(ctx).Where("uuid IN ?", uuids).Find(&documents).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return documents, nil
+}
+
+func (r *ConsentRepository) ExistsByVersion(ctx context.Context, documentType, version string) (bool, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).Model(&models.ConsentDocument{}).
+		Where("type = ? AND version = ?", documentType, version).
+		Count(&count).Error
+	if err != nil {
+		return false, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return count > 0, nil
+}
+
+func (r *ConsentRepository) Create(ctx context.Context, document *models.ConsentDocument) error {
+	err := r.db.WithContext(ctx).Create(document).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *ConsentRepository) FindAcceptances(ctx context.Context, userID uint) ([]models.ConsentAcceptance, error) {
+	var acceptances []models.ConsentAcceptance
+
+	err := r.db.WithContext(ctx).Preload("Document").Where("user_id = ?", userID).Order("accepted_at desc").Find(&acceptances).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return acceptances, nil
+}
+
+// Accept keeps the first acceptance of a document so the original proof is
+// never overwritten.
+func (r *ConsentRepository) Accept(ctx context.Context, acceptances []models.ConsentAcceptance) error {
+	err := r.db.WithContext(ctx).Omit("User", "Document").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "document_id"}},
+		DoNothing: true,
+	}).Create(&acceptances).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *ConsentRepository) CountActiveUsers(ctx context.Context) (int64, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).Model(&models.User{}).Where("status = ?", constants.UserStatusActive).Count(&count).Error
+	if err != nil {
+		return 0, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return count, nil
+}
+
+// CountAcceptances counts, per document, the active users who accepted it.
+func (r *ConsentRepository) CountAcceptances(ctx context.Context) (map[uint]int64, error) {
+	var rows []acceptanceCount
+
+	err := r.db.WithContext(ctx).Model(&models.ConsentAcceptance{}).
+		Select("consent_acceptances.document_id, COUNT(*) AS total").
+		Joins("JOIN users ON users.id = consent_acceptances.user_id").
+		Where("users.status = ? AND users.deleted_at IS NULL", constants.UserStatusActive).
+		Group("consent_acceptances.document_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	counts := make(map[uint]int64, len(rows))
+	for _, row := range rows {
+		counts[row.DocumentID] = row.Total
+	}
+
+	return counts, nil
+}
+
+// ScrubAcceptances keeps the user's acceptances as proof of consent but drops
+// the IP address and user agent recorded with them.
+func (r *ConsentRepository) ScrubAcceptances(ctx context.Context, userID uint) error {
+	err := r.db.WithContext(ctx).Model(&models.ConsentAcceptance{}).Where("user_id = ?", userID).Updates(map[string]any{
+		"ip_address": "",
+		"user_agent": "",
+	}).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+	wrapError "user-service/common/error"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DataExportRepository struct {
+	db *gorm.DB
+}
+
+type IDataExportRepository interface {
+	Create(context.Context, uint) (*models.DataExport, error)
+	Update(context.Context, *models.DataExport) error
+	FindByUUID(context.Context, string) (*models.DataExport, error)
+	Claim(context.Context, time.Time, time.Time, int) ([]models.DataExport, error)
+	FindExpired(context.Context, time.Time, int) ([]models.DataExport, error)
+	DeleteByUserID(context.Context, uint) ([]string, error)
+}
+
+func NewDataExportRepository(db *gorm.DB) IDataExportRepository {
+	return &DataExportRepository{db: db}
+}
+
+func (r *DataExportRepository) Create(ctx context.Context, userID uint) (*models.DataExport, error) {
+	export := &models.DataExport{
+		UUID:   uuid.New(),
+		UserID: userID,
+		Status: constants.DataExportStatusPending,
+	}
+
+	err := r.db.WithContext(ctx).Create(export).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return export, nil
+}
+
+func (r *DataExportRepository) Update(ctx context.Context, export *models.DataExport) error {
+	err := r.db.WithContext(ctx).Model(export).Select("status", "file_path", "error", "completed_at", "expires_at").Updates(export).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *DataExportRepository) FindByUUID(ctx context.Context, uuid string) (*models.DataExport, error) {
+	var export models.DataExport
+
+	err := r.db.WithContext(ctx).Preload("User").Where("uuid = ?", uuid).First(&export).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrDataExportNotFound
+		}
+
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return &export, nil
+}
+
+// Claim marks up to limit exports as processing and returns them. Pending
+// exports are claimed, as are processing ones last touched before staleBefore,
+// whose worker is presumed dead. Rows locked by a concurrent claim are skipped.
+func (r *DataExportRepository) Claim(ctx context.Context, now, staleBefore time.Time, limit int) ([]models.DataExport, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.DataExport{}).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? OR (status = ? AND updated_at < ?)",
+				constants.DataExportStatusPending, constants.DataExportStatusProcessing, staleBefore).
+			Order("created_at asc").
+			Limit(limit).
+			Pluck("id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+
+		return tx.Model(&models.DataExport{}).Where("id IN ?", ids).Updates(map[string]any{
+			"status":     constants.DataExportStatusProcessing,
+			"updated_at": now,
+		}).Error
+	})
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var exports []models.DataExport
+	err = r.db.WithContext(ctx).
+		Preload("User.Role").
+		Where("id IN ?", ids).
+		Order("created_at asc").
+		Find(&exports).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return exports, nil
+}
+
+func (r *DataExportRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]models.DataExport, error) {
+	var exports []models.DataExport
+
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND expires_at <= ?", constants.DataExportStatusReady, now).
+		Limit(limit).
+		Find(&exports).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return exports, nil
+}
+
+// DeleteByUserID removes the user's exports and returns the paths of their
+// archives, which the caller deletes once the removal is committed.
+func (r *DataExportRepository) DeleteByUserID(ctx context.Context, userID uint) ([]string, error) {
+	var exports []models.DataExport
+	err := r.db.WithContext(ctx).Clauses(clause.Returning{Columns: []clause.Column{{Name: "file_path"}}}).
+		Where("user_id = ?", userID).
+		Delete(&exports).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	paths := make([]string, 0, len(exports))
+	for _, export := range exports {
+		if export.FilePath != "" {
+			paths = append(paths, export.FilePath)
+		}
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,186 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+	wrapError "user-service/common/error"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type EmergencyInfoRepository struct {
+	db *gorm.DB
+}
+
+type IEmergencyInfoRepository interface {
+	FindByUserID(context.Context, uint) (*models.EmergencyInfo, error)
+	Save(context.Context, *models.EmergencyInfo) error
+	DeleteByUserID(context.Context, uint) error
+	CreateGrant(context.Context, *models.EmergencyAccessGrant) error
+	FindGrants(context.Context, uint) ([]models.EmergencyAccessGrant, error)
+	FindGrantByUUID(context.Context, uint, string) (*models.EmergencyAccessGrant, error)
+	RevokeGrant(context.Context, *models.EmergencyAccessGrant) error
+	HasActiveGrant(context.Context, uint, uint, time.Time) (bool, error)
+	CreateAccessLog(context.Context, *models.EmergencyAccessLog) error
+	FindAccessLogs(context.Context, uint, int) ([]models.EmergencyAccessLog, error)
+	DeleteAccessLogs(context.Context, uint) error
+}
+
+func NewEmergencyInfoRepository(db *gorm.DB) IEmergencyInfoRepository {
+	return &EmergencyInfoRepository{db: db}
+}
+
+func (r *EmergencyInfoRepository) FindByUserID(ctx context.Context, userID uint) (*models.EmergencyInfo, error) {
+	var info models.EmergencyInfo
+
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&info).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrEmergencyInfoNotFound
+		}
+
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return &info, nil
+}
+
+func (r *EmergencyInfoRepository) Save(ctx context.Context, info *models.EmergencyInfo) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"data", "updated_at"}),
+	}).Create(info).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *EmergencyInfoRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("user_id = ?", userID).Delete(&models.EmergencyInfo{}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Where("user_id = ?", userID).Delete(&models.EmergencyAccessGrant{}).Error
+	})
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *EmergencyInfoRepository) CreateGrant(ctx context.Context, grant *models.EmergencyAccessGrant) error {
+	err := r.db.WithContext(ctx).Create(grant).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *EmergencyInfoRepository) FindGrants(ctx context.Context, userID uint) ([]models.EmergencyAccessGrant, error) {
+	var grants []models.EmergencyAccessGrant
+
+	err := r.db.WithContext(ctx).
+		Preload("Grantee").
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Order("created_at desc").
+		Find(&grants).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return grants, nil
+}
+
+func (r *EmergencyInfoRepository) FindGrantByUUID(ctx context.Context, userID uint, uuid string) (*models.EmergencyAccessGrant, error) {
+	var grant models.EmergencyAccessGrant
+
+	err := r.db.WithContext(ctx).Preload("Grantee").Where("user_id = ? AND uuid = ?", userID, uuid).First(&grant).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrAccessGrantNotFound
+		}
+
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return &grant, nil
+}
+
+func (r *EmergencyInfoRepository) RevokeGrant(ctx context.Context, grant *models.EmergencyAccessGrant) error {
+	err := r.db.WithContext(ctx).Model(grant).Update("revoked_at", grant.RevokedAt).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *EmergencyInfoRepository) HasActiveGrant(ctx context.Context, userID, granteeID uint, now time.Time) (bool, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).Model(&models.EmergencyAccessGrant{}).
+		Where("user_id = ? AND grantee_id = ? AND revoked_at IS NULL", userID, granteeID).
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		Count(&count).Error
+	if err != nil {
+		return false, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return count > 0, nil
+}
+
+func (r *EmergencyInfoRepository) CreateAccessLog(ctx context.Context, log *models.EmergencyAccessLog) error {
+	err := r.db.WithContext(ctx).Create(log).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *EmergencyInfoRepository) FindAccessLogs(ctx context.Context, userID uint, limit int) ([]models.EmergencyAccessLog, error) {
+	var logs []models.EmergencyAccessLog
+
+	err := r.db.WithContext(ctx).
+		Preload("Actor").
+		Where("user_id = ?", userID).
+		Order("created_at desc").
+		Limit(limit).
+		Find(&logs).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return logs, nil
+}
+
+// DeleteAccessLogs removes the log of access to the user's emergency info and
+// strips the user's IP address and user agent from their access to others'.
+func (r *EmergencyInfoRepository) DeleteAccessLogs(ctx context.Context, userID uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("user_id = ?", userID).Delete(&models.EmergencyAccessLog{}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&models.EmergencyAccessLog{}).Where("actor_id = ?", userID).Updates(map[string]any{
+			"ip_address": "",
+			"user_agent": "",
+		}).Error
+	})
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
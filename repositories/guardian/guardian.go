@@ -0,0 +1,157 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	wrapError "user-service/common/error"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type GuardianRepository struct {
+	db *gorm.DB
+}
+
+type IGuardianRepository interface {
+	Link(context.Context, uint, uint, *models.GuardianApproval) error
+	IsGuardian(context.Context, uint, uint) (bool, error)
+	FindMinors(context.Context, uint) ([]models.User, error)
+	CreateApproval(context.Context, *models.GuardianApproval) error
+	UpdateApproval(context.Context, *models.GuardianApproval) error
+	FindApprovalByUUID(context.Context, string) (*models.GuardianApproval, error)
+	FindPendingApprovals(context.Context, uint) ([]models.GuardianApproval, error)
+	FindPendingApprovalByMinor(context.Context, uint, string) (*models.GuardianApproval, error)
+	ScrubApprovals(context.Context, uint) error
+}
+
+func NewGuardianRepository(db *gorm.DB) IGuardianRepository {
+	return &GuardianRepository{db: db}
+}
+
+func (r *GuardianRepository) Link(ctx context.Context, guardianID, minorID uint, approval *models.GuardianApproval) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&models.GuardianLink{GuardianID: guardianID, MinorID: minorID}).Error
+		if err != nil {
+			return err
+		}
+
+		if approval == nil {
+			return nil
+		}
+
+		return tx.Create(approval).Error
+	})
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *GuardianRepository) IsGuardian(ctx context.Context, guardianID, minorID uint) (bool, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).Model(&models.GuardianLink{}).
+		Where("guardian_id = ? AND minor_id = ?", guardianID, minorID).
+		Count(&count).Error
+	if err != nil {
+		return false, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return count > 0, nil
+}
+
+func (r *GuardianRepository) FindMinors(ctx context.Context, guardianID uint) ([]models.User, error) {
+	var users []models.User
+
+	err := r.db.WithContext(ctx).
+		Preload("Role").
+		Where("id IN (?)", r.db.Model(&models.GuardianLink{}).Select("minor_id").Where("guardian_id = ?", guardianID)).
+		Order("name asc").
+		Find(&users).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return users, nil
+}
+
+func (r *GuardianRepository) CreateApproval(ctx context.Context, approval *models.GuardianApproval) error {
+	err := r.db.WithContext(ctx).Create(approval).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *GuardianRepository) UpdateApproval(ctx context.Context, approval *models.GuardianApproval) error {
+	err := r.db.WithContext(ctx).Model(approval).Select("payload", "status", "decided_by_id", "decided_at").Updates(approval).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *GuardianRepository) FindApprovalByUUID(ctx context.Context, uuid string) (*models.GuardianApproval, error) {
+	var approval models.GuardianApproval
+
+	err := r.db.WithContext(ctx).Preload("Minor.Role").Where("uuid = ?", uuid).First(&approval).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrApprovalNotFound
+		}
+
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return &approval, nil
+}
+
+func (r *GuardianRepository) FindPendingApprovals(ctx context.Context, guardianID uint) ([]models.GuardianApproval, error) {
+	var approvals []models.GuardianApproval
+
+	err := r.db.WithContext(ctx).
+		Preload("Minor.Role").
+		Where("status = ?", constants.GuardianApprovalStatusPending).
+		Where("minor_id IN (?)", r.db.Model(&models.GuardianLink{}).Select("minor_id").Where("guardian_id = ?", guardianID)).
+		Order("created_at asc").
+		Find(&approvals).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return approvals, nil
+}
+
+func (r *GuardianRepository) FindPendingApprovalByMinor(ctx context.Context, minorID uint, approvalType string) (*models.GuardianApproval, error) {
+	var approval models.GuardianApproval
+
+	err := r.db.WithContext(ctx).
+		Where("minor_id = ? AND type = ? AND status = ?", minorID, approvalType, constants.GuardianApprovalStatusPending).
+		Order("created_at desc").
+		First(&approval).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrApprovalNotFound
+		}
+
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return &approval, nil
+}
+
+// ScrubApprovals drops the requested changes stored with the minor's approvals.
+func (r *GuardianRepository) ScrubApprovals(ctx context.Context, minorID uint) error {
+	err := r.db.WithContext(ctx).Model(&models.GuardianApproval{}).Where("minor_id = ?", minorID).Update("payload", nil).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+	wrapError "user-service/common/error"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type MembershipRepository struct {
+	db *gorm.DB
+}
+
+type IMembershipRepository interface {
+	FindByUserID(context.Context, uint) ([]models.Membership, error)
+	FindCurrent(context.Context, uint, time.Time) (*models.Membership, error)
+	Grant(context.Context, *models.Membership) error
+	Revoke(context.Context, uint, string, string) error
+	ExpireDue(context.Context, time.Time) (int64, error)
+}
+
+func NewMembershipRepository(db *gorm.DB) IMembershipRepository {
+	return &MembershipRepository{db: db}
+}
+
+func (r *MembershipRepository) FindByUserID(ctx context.Context, userID uint) ([]models.Membership, error) {
+	var memberships []models.Membership
+
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at desc").Find(&memberships).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return memberships, nil
+}
+
+// FindCurrent returns the membership in effect at now, ignoring memberships
+// scheduled to start later.
+func (r *MembershipRepository) FindCurrent(ctx context.Context, userID uint, now time.Time) (*models.Membership, error) {
+	var membership models.Membership
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status = ? AND starts_at <= ?", userID, constants.MembershipStatusActive, now).
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		Order("starts_at desc").
+		First(&membership).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrMembershipNotFound
+		}
+
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return &membership, nil
+}
+
+// Grant replaces every active or scheduled membership of the user with the
+// given one.
+func (r *MembershipRepository) Grant(ctx context.Context, membership *models.Membership) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.Membership{}).
+			Where("user_id = ? AND status = ?", membership.UserID, constants.MembershipStatusActive).
+			Updates(map[string]any{
+				"status":        constants.MembershipStatusRevoked,
+				"revoked_by":    membership.GrantedBy,
+				"revoke_reason": constants.MembershipReasonReplaced,
+				"revoked_at":    time.Now(),
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Omit("User").Create(membership).Error
+	})
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *MembershipRepository) Revoke(ctx context.Context, userID uint, actor, reason string) error {
+	result := r.db.WithContext(ctx).Model(&models.Membership{}).
+		Where("user_id = ? AND status = ?", userID, constants.MembershipStatusActive).
+		Updates(map[string]any{
+			"status":        constants.MembershipStatusRevoked,
+			"revoked_by":    actor,
+			"revoke_reason": reason,
+			"revoked_at":    time.Now(),
+		})
+	if result.Error != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+	if result.RowsAffected == 0 {
+		return errConstant.ErrMembershipNotFound
+	}
+
+	return nil
+}
+
+func (r *MembershipRepository) ExpireDue(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&models.Membership{}).
+		Where("status = ? AND expires_at <= ?", constants.MembershipStatusActive, now).
+		Update("status", constants.MembershipStatusExpired)
+	if result.Error != nil {
+		return 0, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+	wrapError "user-service/common/error"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PhoneRepository struct {
+	db *gorm.DB
+}
+
+type IPhoneRepository interface {
+	FindByUserID(context.Context, uint) (*models.PhoneVerification, error)
+	Save(context.Context, *models.PhoneVerification) error
+	ConsumeAttempt(context.Context, *models.PhoneVerification, int) error
+	MarkVerified(context.Context, *models.PhoneVerification, time.Time) error
+	DeleteByUserID(context.Context, uint) error
+}
+
+func NewPhoneRepository(db *gorm.DB) IPhoneRepository {
+	return &PhoneRepository{db: db}
+}
+
+func (r *PhoneRepository) FindByUserID(ctx context.Context, userID uint) (*models.PhoneVerification, error) {
+	var verification models.PhoneVerification
+
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&verification).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrPhoneVerificationNotFound
+		}
+
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return &verification, nil
+}
+
+func (r *PhoneRepository) Save(ctx context.Context, verification *models.PhoneVerification) error {
+	err := r.db.WithContext(ctx).Omit("User").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"phone_number", "code_hash", "attempts", "expires_at", "created_at", "updated_at"}),
+	}).Create(verification).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+// ConsumeAttempt counts an attempt against the verification, refusing once
+// maxAttempts have been used. The check and the increment are one statement so
+// concurrent guesses cannot exceed the limit.
+func (r *PhoneRepository) ConsumeAttempt(ctx context.Context, verification *models.PhoneVerification, maxAttempts int) error {
+	result := r.db.WithContext(ctx).Model(&models.PhoneVerification{}).
+		Where("id = ? AND attempts < ?", verification.ID, maxAttempts).
+		Update("attempts", gorm.Expr("attempts + 1"))
+	if result.Error != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+	if result.RowsAffected == 0 {
+		return errConstant.ErrOtpTooManyAttempts
+	}
+
+	return nil
+}
+
+// MarkVerified stamps the user's phone as verified, provided it is still the
+// number the code was sent to, and consumes the verification.
+func (r *PhoneRepository) MarkVerified(ctx context.Context, verification *models.PhoneVerification, verifiedAt time.Time) error {
+	var updated int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.User{}).
+			Where("id = ? AND phone_number = ?", verification.UserID, verification.PhoneNumber).
+			Update("phone_verified_at", verifiedAt)
+		if result.Error != nil {
+			return result.Error
+		}
+		updated = result.RowsAffected
+
+		return tx.Delete(verification).Error
+	})
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+	if updated == 0 {
+		return errConstant.ErrPhoneVerificationNotFound
+	}
+
+	return nil
+}
+
+func (r *PhoneRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&models.PhoneVerification{}).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
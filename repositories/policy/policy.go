@@ -0,0 +1,55 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	wrapError "user-service/common/error"
+	"user-service/common/policy"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type PolicyRepository struct {
+	db *gorm.DB
+}
+
+type IPolicyRepository interface {
+	Load(context.Context) (*policy.Document, error)
+	FindLatest(context.Context) (*models.Policy, error)
+}
+
+func NewPolicyRepository(db *gorm.DB) IPolicyRepository {
+	return &PolicyRepository{db: db}
+}
+
+func (r *PolicyRepository) Load(ctx context.Context) (*policy.Document, error) {
+	latest, err := r.FindLatest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := policy.Parse([]byte(latest.Document))
+	if err != nil {
+		return nil, err
+	}
+	document.Version = latest.Version
+
+	return document, nil
+}
+
+func (r *PolicyRepository) FindLatest(ctx context.Context) (*models.Policy, error) {
+	var latest models.Policy
+
+	err := r.db.WithContext(ctx).Order("version desc").First(&latest).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrPolicyNotFound
+		}
+
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return &latest, nil
+}
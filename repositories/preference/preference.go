@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	wrapError "user-service/common/error"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PreferenceRepository struct {
+	db *gorm.DB
+}
+
+type IPreferenceRepository interface {
+	FindByUserID(context.Context, uint) (*models.UserPreference, error)
+	Save(context.Context, *models.UserPreference) error
+	DeleteByUserID(context.Context, uint) error
+}
+
+func NewPreferenceRepository(db *gorm.DB) IPreferenceRepository {
+	return &PreferenceRepository{db: db}
+}
+
+func (r *PreferenceRepository) FindByUserID(ctx context.Context, userID uint) (*models.UserPreference, error) {
+	var preference models.UserPreference
+
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&preference).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrPreferenceNotFound
+		}
+
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return &preference, nil
+}
+
+func (r *PreferenceRepository) Save(ctx context.Context, preference *models.UserPreference) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"values", "updated_at"}),
+	}).Create(preference).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *PreferenceRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&models.UserPreference{}).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
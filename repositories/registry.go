@@ -1,7 +1,20 @@
 package repositories
 
 import (
-	repositories "user-service/repositories/user"
+	"context"
+	athleteProfileRepositories "user-service/repositories/athleteprofile"
+	attributeRepositories "user-service/repositories/attribute"
+	consentRepositories "user-service/repositories/consent"
+	dataExportRepositories "user-service/repositories/dataexport"
+	emergencyInfoRepositories "user-service/repositories/emergencyinfo"
+	guardianRepositories "user-service/repositories/guardian"
+	membershipRepositories "user-service/repositories/membership"
+	phoneRepositories "user-service/repositories/phone"
+	policyRepositories "user-service/repositories/policy"
+	preferenceRepositories "user-service/repositories/preference"
+	suspensionRepositories "user-service/repositories/suspension"
+	userRepositories "user-service/repositories/user"
+	usernameRepositories "user-service/repositories/username"
 
 	"gorm.io/gorm"
 )
@@ -11,13 +24,82 @@ type Registry struct {
 }
 
 type IRepositoryRegistry interface {
-	GetUser() repositories.IUserRepository
+	GetUser() userRepositories.IUserRepository
+	GetPolicy() policyRepositories.IPolicyRepository
+	GetDataExport() dataExportRepositories.IDataExportRepository
+	GetAthleteProfile() athleteProfileRepositories.IAthleteProfileRepository
+	GetGuardian() guardianRepositories.IGuardianRepository
+	GetEmergencyInfo() emergencyInfoRepositories.IEmergencyInfoRepository
+	GetPreference() preferenceRepositories.IPreferenceRepository
+	GetAttribute() attributeRepositories.IAttributeRepository
+	GetMembership() membershipRepositories.IMembershipRepository
+	GetConsent() consentRepositories.IConsentRepository
+	GetSuspension() suspensionRepositories.ISuspensionRepository
+	GetUsername() usernameRepositories.IUsernameRepository
+	GetPhone() phoneRepositories.IPhoneRepository
+	Transaction(context.Context, func(IRepositoryRegistry) error) error
 }
 
 func NewRepositoryRegistry(db *gorm.DB) IRepositoryRegistry {
 	return &Registry{db: db}
 }
 
-func (r *Registry) GetUser() repositories.IUserRepository {
-	return repositories.NewUserRepository(r.db)
+func (r *Registry) GetUser() userRepositories.IUserRepository {
+	return userRepositories.NewUserRepository(r.db)
+}
+
+func (r *Registry) GetPolicy() policyRepositories.IPolicyRepository {
+	return policyRepositories.NewPolicyRepository(r.db)
+}
+
+func (r *Registry) GetDataExport() dataExportRepositories.IDataExportRepository {
+	return dataExportRepositories.NewDataExportRepository(r.db)
+}
+
+func (r *Registry) GetAthleteProfile() athleteProfileRepositories.IAthleteProfileRepository {
+	return athleteProfileRepositories.NewAthleteProfileRepository(r.db)
+}
+
+func (r *Registry) GetGuardian() guardianRepositories.IGuardianRepository {
+	return guardianRepositories.NewGuardianRepository(r.db)
+}
+
+func (r *Registry) GetEmergencyInfo() emergencyInfoRepositories.IEmergencyInfoRepository {
+	return emergencyInfoRepositories.NewEmergencyInfoRepository(r.db)
+}
+
+func (r *Registry) GetPreference() preferenceRepositories.IPreferenceRepository {
+	return preferenceRepositories.NewPreferenceRepository(r.db)
+}
+
+func (r *Registry) GetAttribute() attributeRepositories.IAttributeRepository {
+	return attributeRepositories.NewAttributeRepository(r.db)
+}
+
+func (r *Registry) GetMembership() membershipRepositories.IMembershipRepository {
+	return membershipRepositories.NewMembershipRepository(r.db)
+}
+
+func (r *Registry) GetConsent() consentRepositories.IConsentRepository {
+	return consentRepositories.NewConsentRepository(r.db)
+}
+
+func (r *Registry) GetSuspension() suspensionRepositories.ISuspensionRepository {
+	return suspensionRepositories.NewSuspensionRepository(r.db)
+}
+
+func (r *Registry) GetUsername() usernameRepositories.IUsernameRepository {
+	return usernameRepositories.NewUsernameRepository(r.db)
+}
+
+func (r *Registry) GetPhone() phoneRepositories.IPhoneRepository {
+	return phoneRepositories.NewPhoneRepository(r.db)
+}
+
+// Transaction runs fn with a registry whose repositories share one database
+// transaction, committed when fn returns nil and rolled back otherwise.
+func (r *Registry) Transaction(ctx context.Context, fn func(IRepositoryRegistry) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&Registry{db: tx})
+	})
 }
@@ -0,0 +1,112 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+	wrapError "user-service/common/error"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type SuspensionRepository struct {
+	db *gorm.DB
+}
+
+type ISuspensionRepository interface {
+	FindByUserID(context.Context, uint) ([]models.Suspension, error)
+	FindOpen(context.Context, uint) (*models.Suspension, error)
+	FindExpired(context.Context, time.Time, int) ([]models.Suspension, error)
+	Create(context.Context, *models.Suspension) error
+	Lift(context.Context, *models.Suspension) error
+}
+
+func NewSuspensionRepository(db *gorm.DB) ISuspensionRepository {
+	return &SuspensionRepository{db: db}
+}
+
+func (r *SuspensionRepository) FindByUserID(ctx context.Context, userID uint) ([]models.Suspension, error) {
+	var suspensions []models.Suspension
+
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at desc").Find(&suspensions).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return suspensions, nil
+}
+
+func (r *SuspensionRepository) FindOpen(ctx context.Context, userID uint) (*models.Suspension, error) {
+	var suspension models.Suspension
+
+	err := r.db.WithContext(ctx).Where("user_id = ? AND lifted_at IS NULL", userID).Order("created_at desc").First(&suspension).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrSuspensionNotFound
+		}
+
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return &suspension, nil
+}
+
+func (r *SuspensionRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]models.Suspension, error) {
+	var suspensions []models.Suspension
+
+	err := r.db.WithContext(ctx).
+		Where("lifted_at IS NULL AND expires_at <= ?", now).
+		Order("expires_at asc").
+		Limit(limit).
+		Find(&suspensions).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return suspensions, nil
+}
+
+// Create records the suspension and marks the user suspended in one
+// transaction.
+func (r *SuspensionRepository) Create(ctx context.Context, suspension *models.Suspension) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Omit("User").Create(suspension).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&models.User{}).Where("id = ?", suspension.UserID).Update("status", constants.UserStatusSuspended).Error
+	})
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+// Lift closes the suspension and reactivates the user, unless the user has
+// moved to another status in the meantime.
+func (r *SuspensionRepository) Lift(ctx context.Context, suspension *models.Suspension) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(suspension).Updates(map[string]any{
+			"lifted_by":   suspension.LiftedBy,
+			"lift_reason": suspension.LiftReason,
+			"lifted_at":   suspension.LiftedAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&models.User{}).
+			Where("id = ? AND status = ?", suspension.UserID, constants.UserStatusSuspended).
+			Update("status", constants.UserStatusActive).Error
+	})
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
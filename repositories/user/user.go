@@ -3,7 +3,12 @@ package repositories
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 	wrapError "user-service/common/error"
+	"user-service/common/pagination"
+	"user-service/constants"
 	errConstant "user-service/constants/error"
 	"user-service/domain/dto"
 	"user-service/domain/models"
@@ -20,9 +25,39 @@ type IUserRepository interface {
 	Register(context.Context, *dto.RegiterRequest) (*models.User, error)
 	Update(context.Context, *dto.UpdateRequest, string) (*models.User, error)
 	UpdatePassword(context.Context, *dto.UpdatePasswordRequest, string) (*models.User, error)
+	RequirePasswordReset(context.Context, string) error
+	UpdatePasswordHash(context.Context, string, string) error
 	FindByUsername(context.Context, string) (*models.User, error)
 	FindByEmail(context.Context, string) (*models.User, error)
 	FindByUUID(context.Context, string) (*models.User, error)
+	FindByUUIDs(context.Context, []string) ([]models.User, error)
+	FindAll(context.Context, *dto.UserFilterRequest, *pagination.Cursor) ([]models.User, error)
+	Search(context.Context, string, int, int) ([]UserSearchMatch, int64, error)
+	ExistsByUsername(context.Context, string) (bool, error)
+	ExistsByEmail(context.Context, string) (bool, error)
+	UpdateStatus(context.Context, string, string) error
+	UpdateAvatar(context.Context, string, string) error
+	UpdateDateOfBirth(context.Context, string, time.Time) error
+	Delete(context.Context, string) error
+	Restore(context.Context, string) error
+	ScheduleDeletion(context.Context, string, time.Time) error
+	CancelDeletion(context.Context, string) error
+	FindDueForDeletion(context.Context, time.Time, int) ([]models.User, error)
+	Anonymize(context.Context, *models.User) error
+	BulkCreate(context.Context, []models.User, int) error
+	Stream(context.Context, *dto.UserFilterRequest, func(*UserRow) error) error
+}
+
+type UserRow struct {
+	models.User
+	RoleCode string
+}
+
+var sortableColumns = map[string]string{
+	"created_at": "created_at",
+	"name":       "name",
+	"username":   "username",
+	"email":      "email",
 }
 
 func NewUserRepository(db *gorm.DB) IUserRepository {
@@ -38,6 +73,17 @@ func (r *UserRepository) Register(ctx context.Context, req *dto.RegiterRequest)
 		Password:    req.Password,
 		PhoneNumber: req.PhoneNumber,
 		RoleId:      req.RoleID,
+		Status:      constants.UserStatusActive,
+	}
+	if req.Status != "" {
+		user.Status = req.Status
+	}
+	if req.DateOfBirth != "" {
+		dateOfBirth, err := time.ParseInLocation(time.DateOnly, req.DateOfBirth, time.Local)
+		if err != nil {
+			return nil, err
+		}
+		user.DateOfBirth = &dateOfBirth
 	}
 
 	err := r.db.WithContext(ctx).Create(user).Error
@@ -56,7 +102,18 @@ func (r *UserRepository) Update(ctx context.Context, req *dto.UpdateRequest, uui
 		PhoneNumber: req.PhoneNumber,
 	}
 
-	err := r.db.WithContext(ctx).Where("uuid = ?", uuid).Updates(user).Error
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if req.PhoneNumber != "" {
+			err := tx.Model(&models.User{}).
+				Where("uuid = ? AND phone_number IS DISTINCT FROM ?", uuid, req.PhoneNumber).
+				Update("phone_verified_at", nil).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Where("uuid = ?", uuid).Updates(user).Error
+	})
 	if err != nil {
 		return nil, wrapError.WrapError(errConstant.ErrSqlError)
 	}
@@ -69,7 +126,10 @@ func (r *UserRepository) UpdatePassword(ctx context.Context, req *dto.UpdatePass
 		Password: req.NewPassword,
 	}
 
-	err := r.db.WithContext(ctx).Where("uuid = ?", uuid).Updates(user).Error
+	err := r.db.WithContext(ctx).Model(&models.User{}).Where("uuid = ?", uuid).Updates(map[string]any{
+		"password":             user.Password,
+		"must_change_password": false,
+	}).Error
 	if err != nil {
 		return nil, wrapError.WrapError(errConstant.ErrSqlError)
 	}
@@ -77,6 +137,24 @@ func (r *UserRepository) UpdatePassword(ctx context.Context, req *dto.UpdatePass
 	return user, nil
 }
 
+func (r *UserRepository) UpdatePasswordHash(ctx context.Context, uuid, hash string) error {
+	err := r.db.WithContext(ctx).Model(&models.User{}).Where("uuid = ?", uuid).Update("password", hash).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *UserRepository) RequirePasswordReset(ctx context.Context, uuid string) error {
+	err := r.db.WithContext(ctx).Model(&models.User{}).Where("uuid = ?", uuid).Update("must_change_password", true).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
 func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*models.User, error) {
 	var user models.User
 
@@ -121,3 +199,423 @@ func (r *UserRepository) FindByUUID(ctx context.Context, uuid string) (*models.U
 
 	return &user, nil
 }
+
+func (r *UserRepository) FindByUUIDs(ctx context.Context, uuids []string) ([]models.User, error) {
+	var users []models.User
+
+	err := r.db.WithContext(ctx).Preload("Role").Where("uuid IN ?", uuids).Find(&users).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return users, nil
+}
+
+func (r *UserRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).Unscoped().Model(&models.User{}).Where("username = ?", username).Count(&count).Error
+	if err != nil {
+		return false, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return count > 0, nil
+}
+
+func (r *UserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).Unscoped().Model(&models.User{}).Where("email = ?", email).Count(&count).Error
+	if err != nil {
+		return false, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return count > 0, nil
+}
+
+func (r *UserRepository) UpdateStatus(ctx context.Context, uuid, status string) error {
+	result := r.db.WithContext(ctx).Model(&models.User{}).Where("uuid = ?", uuid).Update("status", status)
+	if result.Error != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	if result.RowsAffected == 0 {
+		return errConstant.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *UserRepository) UpdateDateOfBirth(ctx context.Context, uuid string, dateOfBirth time.Time) error {
+	result := r.db.WithContext(ctx).Model(&models.User{}).Where("uuid = ?", uuid).Update("date_of_birth", dateOfBirth)
+	if result.Error != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	if result.RowsAffected == 0 {
+		return errConstant.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *UserRepository) UpdateAvatar(ctx context.Context, uuid, avatarPath string) error {
+	result := r.db.WithContext(ctx).Model(&models.User{}).Where("uuid = ?", uuid).Update("avatar_path", avatarPath)
+	if result.Error != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	if result.RowsAffected == 0 {
+		return errConstant.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *UserRepository) Delete(ctx context.Context, uuid string) error {
+	result := r.db.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.User{})
+	if result.Error != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	if result.RowsAffected == 0 {
+		return errConstant.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *UserRepository) Restore(ctx context.Context, uuid string) error {
+	var user models.User
+
+	err := r.db.WithContext(ctx).Unscoped().Where("uuid = ?", uuid).First(&user).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errConstant.ErrNotFound
+		}
+
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	if !user.DeletedAt.Valid {
+		return errConstant.ErrUserNotDeleted
+	}
+
+	err = r.db.WithContext(ctx).Unscoped().Model(&user).Update("deleted_at", nil).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *UserRepository) ScheduleDeletion(ctx context.Context, uuid string, scheduledAt time.Time) error {
+	err := r.db.WithContext(ctx).Model(&models.User{}).Where("uuid = ?", uuid).Updates(map[string]any{
+		"status":                constants.UserStatusPendingDeletion,
+		"deletion_scheduled_at": scheduledAt,
+	}).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *UserRepository) CancelDeletion(ctx context.Context, uuid string) error {
+	err := r.db.WithContext(ctx).Model(&models.User{}).Where("uuid = ?", uuid).Updates(map[string]any{
+		"status":                constants.UserStatusActive,
+		"deletion_scheduled_at": nil,
+	}).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *UserRepository) FindDueForDeletion(ctx context.Context, now time.Time, limit int) ([]models.User, error) {
+	var users []models.User
+
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND deletion_scheduled_at <= ?", constants.UserStatusPendingDeletion, now).
+		Order("deletion_scheduled_at asc").
+		Limit(limit).
+		Find(&users).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return users, nil
+}
+
+func (r *UserRepository) Anonymize(ctx context.Context, user *models.User) error {
+	now := time.Now()
+	err := r.db.WithContext(ctx).Model(&models.User{}).Where("id = ?", user.ID).Updates(map[string]any{
+		"name":              "Deleted User",
+		"username":          fmt.Sprintf(constants.AnonymizedUsernameFormat, user.ID),
+		"email":             fmt.Sprintf("%s@deleted.invalid", user.UUID),
+		"phone_number":      "",
+		"phone_verified_at": nil,
+		"avatar_path":       "",
+		"date_of_birth":     nil,
+		"password":          "!" + uuid.NewString(),
+		"status":            constants.UserStatusAnonymized,
+		"anonymized_at":     now,
+	}).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *UserRepository) BulkCreate(ctx context.Context, users []models.User, batchSize int) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(users, batchSize).Error
+	})
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *UserRepository) Stream(ctx context.Context, filter *dto.UserFilterRequest, fn func(*UserRow) error) error {
+	db := r.db.WithContext(ctx)
+	rows, err := applyUserFilter(db.Model(&models.User{}), filter).
+		Select("users.*, (SELECT code FROM roles WHERE roles.id = users.role_id) AS role_code").
+		Order("users.id asc").
+		Rows()
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row UserRow
+		err = db.ScanRows(rows, &row)
+		if err != nil {
+			return wrapError.WrapError(errConstant.ErrSqlError)
+		}
+
+		err = fn(&row)
+		if err != nil {
+			return err
+		}
+	}
+
+	if rows.Err() != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+func (r *UserRepository) FindAll(ctx context.Context, filter *dto.UserFilterRequest, cursor *pagination.Cursor) ([]models.User, error) {
+	var users []models.User
+
+	column, descending := SortColumn(filter.Sort)
+	direction, comparison := "asc", ">"
+	if descending {
+		direction, comparison = "desc", "<"
+	}
+
+	query := applyUserFilter(r.db.WithContext(ctx).Model(&models.User{}).Preload("Role"), filter)
+	if cursor != nil {
+		var value any = cursor.Value
+		if column == "created_at" {
+			createdAt, err := time.Parse(time.RFC3339Nano, cursor.Value)
+			if err != nil {
+				return nil, errConstant.ErrInvalidCursor
+			}
+			value = createdAt
+		}
+		query = query.Where(fmt.Sprintf("(%s, id) %s (?, ?)", column, comparison), value, cursor.ID)
+	}
+
+	err := query.
+		Order(fmt.Sprintf("%s %s, id %s", column, direction, direction)).
+		Limit(filter.Limit + 1).
+		Find(&users).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return users, nil
+}
+
+func SortColumn(sort string) (string, bool) {
+	descending := strings.HasPrefix(sort, "-")
+	column, ok := sortableColumns[strings.TrimPrefix(sort, "-")]
+	if !ok {
+		return "created_at", true
+	}
+
+	return column, descending
+}
+
+func applyUserFilter(query *gorm.DB, filter *dto.UserFilterRequest) *gorm.DB {
+	switch filter.Status {
+	case "":
+	case constants.UserStatusDeleted:
+		query = query.Unscoped().Where("deleted_at IS NOT NULL")
+	default:
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	if filter.Role != "" {
+		query = query.Where("role_id IN (?)", query.Session(&gorm.Session{NewDB: true}).
+			Model(&models.Role{}).
+			Select("id").
+			Where("LOWER(code) = LOWER(?)", filter.Role))
+	}
+
+	if filter.CreatedFrom != "" {
+		createdFrom, err := time.ParseInLocation(time.DateOnly, filter.CreatedFrom, time.Local)
+		if err == nil {
+			query = query.Where("created_at >= ?", createdFrom)
+		}
+	}
+
+	if filter.CreatedTo != "" {
+		createdTo, err := time.ParseInLocation(time.DateOnly, filter.CreatedTo, time.Local)
+		if err == nil {
+			query = query.Where("created_at < ?", createdTo.AddDate(0, 0, 1))
+		}
+	}
+
+	if filter.EmailDomain != "" {
+		query = query.Where("email ILIKE ?", "%@"+escapeLike(strings.TrimPrefix(filter.EmailDomain, "@")))
+	}
+
+	return query
+}
+
+type UserSearchMatch struct {
+	User models.User
+	Rank float64
+}
+
+func (r *UserRepository) Search(ctx context.Context, keyword string, limit, offset int) ([]UserSearchMatch, int64, error) {
+	var (
+		rows []struct {
+			ID   uint
+			Rank float64
+		}
+		total int64
+	)
+
+	trigram, err := r.isTrigramAvailable(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var rank, condition string
+	var rankArgs, conditionArgs []any
+	if trigram {
+		rank, rankArgs, condition, conditionArgs = trigramSearch(keyword)
+	} else {
+		rank, rankArgs, condition, conditionArgs = likeSearch(keyword)
+	}
+
+	query := r.db.WithContext(ctx).Model(&models.User{}).Where(condition, conditionArgs...).Session(&gorm.Session{})
+	err = query.Count(&total).Error
+	if err != nil {
+		return nil, 0, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	err = query.
+		Select(fmt.Sprintf("id, %s AS rank", rank), rankArgs...).
+		Order("rank desc, id asc").
+		Limit(limit).
+		Offset(offset).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, 0, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	if len(rows) == 0 {
+		return []UserSearchMatch{}, total, nil
+	}
+
+	ids := make([]uint, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+
+	var users []models.User
+	err = r.db.WithContext(ctx).Preload("Role").Where("id IN ?", ids).Find(&users).Error
+	if err != nil {
+		return nil, 0, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	usersByID := make(map[uint]models.User, len(users))
+	for _, user := range users {
+		usersByID[user.ID] = user
+	}
+
+	matches := make([]UserSearchMatch, 0, len(rows))
+	for _, row := range rows {
+		user, ok := usersByID[row.ID]
+		if !ok {
+			continue
+		}
+		matches = append(matches, UserSearchMatch{User: user, Rank: row.Rank})
+	}
+
+	return matches, total, nil
+}
+
+func (r *UserRepository) isTrigramAvailable(ctx context.Context) (bool, error) {
+	var available bool
+
+	err := r.db.WithContext(ctx).
+		Raw("SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_trgm')").
+		Scan(&available).Error
+	if err != nil {
+		return false, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return available, nil
+}
+
+func trigramSearch(keyword string) (string, []any, string, []any) {
+	like := "%" + escapeLike(keyword) + "%"
+	rank := "GREATEST(word_similarity(?, name), word_similarity(?, username), similarity(?, email), similarity(?, phone_number))"
+	condition := "? <% name OR ? <% username OR email % ? OR phone_number % ? OR " +
+		"name ILIKE ? OR username ILIKE ? OR email ILIKE ? OR phone_number ILIKE ?"
+
+	return rank, []any{keyword, keyword, keyword, keyword},
+		condition, []any{keyword, keyword, keyword, keyword, like, like, like, like}
+}
+
+func likeSearch(keyword string) (string, []any, string, []any) {
+	var (
+		ranks, conditions       []string
+		rankArgs, conditionArgs []any
+		fields                  = []string{"name", "username", "email", "phone_number"}
+		tokens                  = strings.Fields(keyword)
+		matchesPerToken         = make([]string, 0, len(fields))
+		maximumRank             = float64(len(tokens) * len(fields))
+	)
+
+	for _, token := range tokens {
+		like := "%" + escapeLike(token) + "%"
+		matchesPerToken = matchesPerToken[:0]
+		for _, field := range fields {
+			ranks = append(ranks, fmt.Sprintf("CASE WHEN %s ILIKE ? THEN 1 ELSE 0 END", field))
+			rankArgs = append(rankArgs, like)
+			matchesPerToken = append(matchesPerToken, fmt.Sprintf("%s ILIKE ?", field))
+			conditionArgs = append(conditionArgs, like)
+		}
+		conditions = append(conditions, "("+strings.Join(matchesPerToken, " OR ")+")")
+	}
+
+	rank := fmt.Sprintf("(%s) / %.1f", strings.Join(ranks, " + "), maximumRank)
+
+	return rank, rankArgs, strings.Join(conditions, " AND "), conditionArgs
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
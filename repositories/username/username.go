@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	wrapError "user-service/common/error"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type UsernameRepository struct {
+	db *gorm.DB
+}
+
+type IUsernameRepository interface {
+	FindByUserID(context.Context, uint) ([]models.UsernameHistory, error)
+	IsHeld(context.Context, string, uint, time.Time) (bool, error)
+	Create(context.Context, *models.UsernameHistory) error
+	Detach(context.Context, uint, time.Time) error
+}
+
+func NewUsernameRepository(db *gorm.DB) IUsernameRepository {
+	return &UsernameRepository{db: db}
+}
+
+func (r *UsernameRepository) FindByUserID(ctx context.Context, userID uint) ([]models.UsernameHistory, error) {
+	var histories []models.UsernameHistory
+
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at desc").Find(&histories).Error
+	if err != nil {
+		return nil, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return histories, nil
+}
+
+// IsHeld reports whether username was released by a user other than userID
+// and is still inside its hold period.
+func (r *UsernameRepository) IsHeld(ctx context.Context, username string, userID uint, now time.Time) (bool, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).Model(&models.UsernameHistory{}).
+		Where("LOWER(username) = LOWER(?) AND (user_id IS NULL OR user_id <> ?) AND held_until > ?", username, userID, now).
+		Count(&count).Error
+	if err != nil {
+		return false, wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return count > 0, nil
+}
+
+func (r *UsernameRepository) Create(ctx context.Context, history *models.UsernameHistory) error {
+	err := r.db.WithContext(ctx).Omit("User").Create(history).Error
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
+
+// Detach removes the history of userID but keeps the usernames still on hold,
+// without any link back to the user.
+func (r *UsernameRepository) Detach(ctx context.Context, userID uint, now time.Time) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("user_id = ? AND held_until <= ?", userID, now).Delete(&models.UsernameHistory{}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&models.UsernameHistory{}).Where("user_id = ?", userID).Updates(map[string]any{
+			"user_id":      nil,
+			"new_username": "",
+			"changed_by":   "",
+		}).Error
+	})
+	if err != nil {
+		return wrapError.WrapError(errConstant.ErrSqlError)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package athleteprofile
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AthleteProfileRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IAthleteProfileRoute interface {
+	Run()
+}
+
+func NewAthleteProfileRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IAthleteProfileRoute {
+	return &AthleteProfileRoute{controller: controller, group: group}
+}
+
+func (a *AthleteProfileRoute) Run() {
+	read := a.group.Group("/auth/:uuid/athlete-profile", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileRead))
+	read.GET("", middlewares.Authorize(constants.ActionUserRead, constants.ResourceUser), a.controller.GetAthleteProfileController().Get)
+
+	write := a.group.Group("/auth/:uuid/athlete-profile", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileWrite))
+	write.Use(middlewares.Authorize(constants.ActionUserUpdate, constants.ResourceUser))
+	write.POST("", a.controller.GetAthleteProfileController().Create)
+	write.PUT("", a.controller.GetAthleteProfileController().Update)
+	write.DELETE("", a.controller.GetAthleteProfileController().Delete)
+}
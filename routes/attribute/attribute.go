@@ -0,0 +1,36 @@
+package attribute
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AttributeRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IAttributeRoute interface {
+	Run()
+}
+
+func NewAttributeRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IAttributeRoute {
+	return &AttributeRoute{controller: controller, group: group}
+}
+
+func (a *AttributeRoute) Run() {
+	read := a.group.Group("/attributes", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileRead))
+	read.GET("", a.controller.GetAttributeController().GetDefinitions)
+
+	manage := a.group.Group("/attributes", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeUsersWrite))
+	manage.Use(middlewares.Authorize(constants.ActionAttributeManage, constants.ResourceAttribute))
+	manage.POST("", a.controller.GetAttributeController().CreateDefinition)
+	manage.PUT("/:uuid", a.controller.GetAttributeController().UpdateDefinition)
+	manage.DELETE("/:uuid", a.controller.GetAttributeController().DeleteDefinition)
+
+	write := a.group.Group("/auth/:uuid/attributes", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileWrite))
+	write.PUT("", middlewares.Authorize(constants.ActionUserUpdate, constants.ResourceUser), a.controller.GetAttributeController().SaveValues)
+}
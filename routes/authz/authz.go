@@ -0,0 +1,28 @@
+package authz
+
+import (
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuthzRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IAuthzRoute interface {
+	Run()
+}
+
+func NewAuthzRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IAuthzRoute {
+	return &AuthzRoute{controller: controller, group: group}
+}
+
+func (a *AuthzRoute) Run() {
+	group := a.group.Group("/internal/authz")
+	group.Use(middlewares.AuthenticateService())
+	group.POST("/check", a.controller.GetAuthzController().Check)
+	group.POST("/check/batch", a.controller.GetAuthzController().BatchCheck)
+}
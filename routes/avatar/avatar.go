@@ -0,0 +1,33 @@
+package avatar
+
+import (
+	"user-service/common/storage"
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AvatarRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IAvatarRoute interface {
+	Run()
+}
+
+func NewAvatarRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IAvatarRoute {
+	return &AvatarRoute{controller: controller, group: group}
+}
+
+func (a *AvatarRoute) Run() {
+	group := a.group.Group("/auth/user/avatar", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileWrite))
+	group.POST("", a.controller.GetAvatarController().Upload)
+	group.DELETE("", a.controller.GetAvatarController().Delete)
+
+	if driver, ok := storage.Default().(*storage.LocalDriver); ok {
+		a.group.Static("/files", driver.Root)
+	}
+}
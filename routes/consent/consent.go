@@ -0,0 +1,36 @@
+package consent
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ConsentRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IConsentRoute interface {
+	Run()
+}
+
+func NewConsentRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IConsentRoute {
+	return &ConsentRoute{controller: controller, group: group}
+}
+
+func (c *ConsentRoute) Run() {
+	c.group.GET("/consents/current", c.controller.GetConsentController().GetCurrent)
+
+	user := c.group.Group("/auth/user/consents", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeConsent))
+	user.GET("", c.controller.GetConsentController().GetMine)
+	user.POST("", c.controller.GetConsentController().Accept)
+
+	manage := c.group.Group("/consents", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeUsersWrite))
+	manage.Use(middlewares.Authorize(constants.ActionConsentManage, constants.ResourceConsent))
+	manage.GET("", c.controller.GetConsentController().ListDocuments)
+	manage.POST("", c.controller.GetConsentController().Publish)
+	manage.GET("/report", c.controller.GetConsentController().Report)
+}
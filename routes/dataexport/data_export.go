@@ -0,0 +1,30 @@
+package dataexport
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DataExportRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IDataExportRoute interface {
+	Run()
+}
+
+func NewDataExportRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IDataExportRoute {
+	return &DataExportRoute{controller: controller, group: group}
+}
+
+func (d *DataExportRoute) Run() {
+	group := d.group.Group("/auth/user/exports", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileRead))
+	group.POST("", d.controller.GetDataExportController().Request)
+	group.GET("/:uuid", d.controller.GetDataExportController().GetByUUID)
+
+	d.group.GET("/exports/:uuid/download", d.controller.GetDataExportController().Download)
+}
@@ -0,0 +1,38 @@
+package emergencyinfo
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EmergencyInfoRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IEmergencyInfoRoute interface {
+	Run()
+}
+
+func NewEmergencyInfoRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IEmergencyInfoRoute {
+	return &EmergencyInfoRoute{controller: controller, group: group}
+}
+
+func (e *EmergencyInfoRoute) Run() {
+	read := e.group.Group("/auth/user/emergency-info", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileRead))
+	read.GET("", e.controller.GetEmergencyInfoController().Get)
+	read.GET("/grants", e.controller.GetEmergencyInfoController().ListGrants)
+	read.GET("/access-logs", e.controller.GetEmergencyInfoController().ListAccessLogs)
+
+	write := e.group.Group("/auth/user/emergency-info", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileWrite))
+	write.PUT("", e.controller.GetEmergencyInfoController().Save)
+	write.DELETE("", e.controller.GetEmergencyInfoController().Delete)
+	write.POST("/grants", e.controller.GetEmergencyInfoController().Grant)
+	write.DELETE("/grants/:uuid", e.controller.GetEmergencyInfoController().Revoke)
+
+	grantee := e.group.Group("/auth/:uuid/emergency-info", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileRead))
+	grantee.GET("", e.controller.GetEmergencyInfoController().GetByUUID)
+}
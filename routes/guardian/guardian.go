@@ -0,0 +1,32 @@
+package guardian
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GuardianRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IGuardianRoute interface {
+	Run()
+}
+
+func NewGuardianRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IGuardianRoute {
+	return &GuardianRoute{controller: controller, group: group}
+}
+
+func (g *GuardianRoute) Run() {
+	read := g.group.Group("/auth/user/guardian", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileRead))
+	read.GET("/minors", g.controller.GetGuardianController().ListMinors)
+	read.GET("/approvals", g.controller.GetGuardianController().ListApprovals)
+
+	write := g.group.Group("/auth/user/guardian", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileWrite))
+	write.POST("/approvals/:uuid/approve", g.controller.GetGuardianController().Approve)
+	write.POST("/approvals/:uuid/reject", g.controller.GetGuardianController().Reject)
+}
@@ -0,0 +1,37 @@
+package membership
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MembershipRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IMembershipRoute interface {
+	Run()
+}
+
+func NewMembershipRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IMembershipRoute {
+	return &MembershipRoute{controller: controller, group: group}
+}
+
+func (m *MembershipRoute) Run() {
+	read := m.group.Group("/auth/:uuid/memberships", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileRead))
+	read.GET("", middlewares.Authorize(constants.ActionUserReadMembership, constants.ResourceUser), m.controller.GetMembershipController().List)
+
+	manage := m.group.Group("/auth/:uuid/memberships", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeUsersWrite))
+	manage.Use(middlewares.Authorize(constants.ActionUserManageMembership, constants.ResourceUser))
+	manage.POST("", m.controller.GetMembershipController().Grant)
+	manage.POST("/revoke", m.controller.GetMembershipController().Revoke)
+
+	internal := m.group.Group("/internal/users", middlewares.AuthenticateService())
+	internal.GET("/:uuid/memberships", m.controller.GetMembershipController().List)
+	internal.POST("/:uuid/memberships", m.controller.GetMembershipController().Grant)
+	internal.POST("/:uuid/memberships/revoke", m.controller.GetMembershipController().Revoke)
+}
@@ -0,0 +1,28 @@
+package phone
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PhoneRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IPhoneRoute interface {
+	Run()
+}
+
+func NewPhoneRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IPhoneRoute {
+	return &PhoneRoute{controller: controller, group: group}
+}
+
+func (p *PhoneRoute) Run() {
+	group := p.group.Group("/auth/user/phone/verification", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileWrite))
+	group.POST("", p.controller.GetPhoneController().RequestVerification)
+	group.POST("/confirm", p.controller.GetPhoneController().ConfirmVerification)
+}
@@ -0,0 +1,35 @@
+package preference
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PreferenceRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IPreferenceRoute interface {
+	Run()
+}
+
+func NewPreferenceRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IPreferenceRoute {
+	return &PreferenceRoute{controller: controller, group: group}
+}
+
+func (p *PreferenceRoute) Run() {
+	p.group.GET("/preferences/schema", p.controller.GetPreferenceController().GetSchema)
+
+	read := p.group.Group("/auth/user/preferences", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileRead))
+	read.GET("", p.controller.GetPreferenceController().Get)
+
+	write := p.group.Group("/auth/user/preferences", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileWrite))
+	write.PATCH("", p.controller.GetPreferenceController().Update)
+
+	internal := p.group.Group("/internal/users", middlewares.AuthenticateService())
+	internal.GET("/:uuid/preferences", p.controller.GetPreferenceController().GetByUUID)
+}
@@ -2,7 +2,22 @@ package routes
 
 import (
 	"user-service/controllers"
+	athleteProfileRoutes "user-service/routes/athleteprofile"
+	attributeRoutes "user-service/routes/attribute"
+	authzRoutes "user-service/routes/authz"
+	avatarRoutes "user-service/routes/avatar"
+	consentRoutes "user-service/routes/consent"
+	dataExportRoutes "user-service/routes/dataexport"
+	emergencyInfoRoutes "user-service/routes/emergencyinfo"
+	guardianRoutes "user-service/routes/guardian"
+	membershipRoutes "user-service/routes/membership"
+	phoneRoutes "user-service/routes/phone"
+	preferenceRoutes "user-service/routes/preference"
+	suspensionRoutes "user-service/routes/suspension"
 	userRoutes "user-service/routes/user"
+	userExportRoutes "user-service/routes/userexport"
+	userImportRoutes "user-service/routes/userimport"
+	usernameRoutes "user-service/routes/username"
 
 	"github.com/gin-gonic/gin"
 )
@@ -25,8 +40,83 @@ func NewRouteRegistry(controller controllers.IControllerRegistry, group *gin.Rou
 
 func (r *Registry) Serve() {
 	r.userRoute().Run()
+	r.authzRoute().Run()
+	r.dataExportRoute().Run()
+	r.userImportRoute().Run()
+	r.userExportRoute().Run()
+	r.avatarRoute().Run()
+	r.athleteProfileRoute().Run()
+	r.guardianRoute().Run()
+	r.emergencyInfoRoute().Run()
+	r.preferenceRoute().Run()
+	r.attributeRoute().Run()
+	r.membershipRoute().Run()
+	r.consentRoute().Run()
+	r.suspensionRoute().Run()
+	r.usernameRoute().Run()
+	r.phoneRoute().Run()
 }
 
 func (r *Registry) userRoute() userRoutes.IUserRoute {
 	return userRoutes.NewUserRoute(r.controller, r.group)
 }
+
+func (r *Registry) authzRoute() authzRoutes.IAuthzRoute {
+	return authzRoutes.NewAuthzRoute(r.controller, r.group)
+}
+
+func (r *Registry) dataExportRoute() dataExportRoutes.IDataExportRoute {
+	return dataExportRoutes.NewDataExportRoute(r.controller, r.group)
+}
+
+func (r *Registry) userImportRoute() userImportRoutes.IUserImportRoute {
+	return userImportRoutes.NewUserImportRoute(r.controller, r.group)
+}
+
+func (r *Registry) userExportRoute() userExportRoutes.IUserExportRoute {
+	return userExportRoutes.NewUserExportRoute(r.controller, r.group)
+}
+
+func (r *Registry) avatarRoute() avatarRoutes.IAvatarRoute {
+	return avatarRoutes.NewAvatarRoute(r.controller, r.group)
+}
+
+func (r *Registry) athleteProfileRoute() athleteProfileRoutes.IAthleteProfileRoute {
+	return athleteProfileRoutes.NewAthleteProfileRoute(r.controller, r.group)
+}
+
+func (r *Registry) guardianRoute() guardianRoutes.IGuardianRoute {
+	return guardianRoutes.NewGuardianRoute(r.controller, r.group)
+}
+
+func (r *Registry) emergencyInfoRoute() emergencyInfoRoutes.IEmergencyInfoRoute {
+	return emergencyInfoRoutes.NewEmergencyInfoRoute(r.controller, r.group)
+}
+
+func (r *Registry) preferenceRoute() preferenceRoutes.IPreferenceRoute {
+	return preferenceRoutes.NewPreferenceRoute(r.controller, r.group)
+}
+
+func (r *Registry) attributeRoute() attributeRoutes.IAttributeRoute {
+	return attributeRoutes.NewAttributeRoute(r.controller, r.group)
+}
+
+func (r *Registry) membershipRoute() membershipRoutes.IMembershipRoute {
+	return membershipRoutes.NewMembershipRoute(r.controller, r.group)
+}
+
+func (r *Registry) consentRoute() consentRoutes.IConsentRoute {
+	return consentRoutes.NewConsentRoute(r.controller, r.group)
+}
+
+func (r *Registry) suspensionRoute() suspensionRoutes.ISuspensionRoute {
+	return suspensionRoutes.NewSuspensionRoute(r.controller, r.group)
+}
+
+func (r *Registry) usernameRoute() usernameRoutes.IUsernameRoute {
+	return usernameRoutes.NewUsernameRoute(r.controller, r.group)
+}
+
+func (r *Registry) phoneRoute() phoneRoutes.IPhoneRoute {
+	return phoneRoutes.NewPhoneRoute(r.controller, r.group)
+}
@@ -0,0 +1,32 @@
+package suspension
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SuspensionRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type ISuspensionRoute interface {
+	Run()
+}
+
+func NewSuspensionRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) ISuspensionRoute {
+	return &SuspensionRoute{controller: controller, group: group}
+}
+
+func (s *SuspensionRoute) Run() {
+	read := s.group.Group("/users/:uuid/suspensions", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeUsersRead))
+	read.GET("", middlewares.Authorize(constants.ActionUserSuspend, constants.ResourceUser), s.controller.GetSuspensionController().List)
+
+	write := s.group.Group("/users/:uuid/suspensions", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeUsersWrite))
+	write.Use(middlewares.Authorize(constants.ActionUserSuspend, constants.ResourceUser))
+	write.POST("", s.controller.GetSuspensionController().Suspend)
+	write.POST("/lift", s.controller.GetSuspensionController().Lift)
+}
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"user-service/constants"
 	"user-service/controllers"
 	"user-service/middlewares"
 
@@ -22,10 +23,32 @@ func NewUserRoute(controller controllers.IControllerRegistry, group *gin.RouterG
 
 func (u *UserRoute) Run() {
 	group := u.group.Group("/auth")
-	group.GET("/user", middlewares.Authenticate(), u.controller.GetUserController().GetUserLogin)
-	group.GET("/:uuid", middlewares.Authenticate(), u.controller.GetUserController().GetUserByUUID)
 	group.POST("/login", u.controller.GetUserController().Login)
 	group.POST("/register", u.controller.GetUserController().Register)
-	group.PUT("/:uuid", middlewares.Authenticate(), u.controller.GetUserController().Update)
-	group.PUT("/update-password/:uuid", middlewares.Authenticate(), u.controller.GetUserController().UpdatePassword)
+
+	profileRead := group.Group("", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileRead))
+	profileRead.GET("/user", u.controller.GetUserController().GetUserLogin)
+
+	profileWrite := group.Group("", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeProfileWrite))
+	profileWrite.PUT("/:uuid", u.controller.GetUserController().Update)
+	profileWrite.DELETE("/user", u.controller.GetUserController().DeleteAccount)
+
+	passwordChange := group.Group("", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopePasswordChange))
+	passwordChange.PUT("/update-password/:uuid", u.controller.GetUserController().UpdatePassword)
+
+	usersRead := group.Group("", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeUsersRead))
+	usersRead.GET("/:uuid", middlewares.Authorize(constants.ActionUserRead, constants.ResourceUser), u.controller.GetUserController().GetUserByUUID)
+
+	admin := u.group.Group("/users", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeUsersRead))
+	admin.GET("", middlewares.Authorize(constants.ActionUserList, constants.ResourceUser), u.controller.GetUserController().ListUsers)
+	admin.GET("/search", middlewares.Authorize(constants.ActionUserSearch, constants.ResourceUser), u.controller.GetUserController().SearchUsers)
+
+	adminWrite := u.group.Group("/users", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeUsersWrite))
+	adminWrite.DELETE("/:uuid", middlewares.Authorize(constants.ActionUserDelete, constants.ResourceUser), u.controller.GetUserController().DeleteUser)
+	adminWrite.POST("/:uuid/restore", middlewares.Authorize(constants.ActionUserRestore, constants.ResourceUser), u.controller.GetUserController().RestoreUser)
+	adminWrite.POST("/:uuid/deactivate", middlewares.Authorize(constants.ActionUserDeactivate, constants.ResourceUser), u.controller.GetUserController().DeactivateUser)
+	adminWrite.POST("/:uuid/reactivate", middlewares.Authorize(constants.ActionUserReactivate, constants.ResourceUser), u.controller.GetUserController().ReactivateUser)
+
+	internal := u.group.Group("/internal/users", middlewares.AuthenticateService())
+	internal.POST("/batch", u.controller.GetUserController().GetUsersByUUIDs)
 }
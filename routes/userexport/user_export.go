@@ -0,0 +1,27 @@
+package userexport
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UserExportRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IUserExportRoute interface {
+	Run()
+}
+
+func NewUserExportRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IUserExportRoute {
+	return &UserExportRoute{controller: controller, group: group}
+}
+
+func (u *UserExportRoute) Run() {
+	group := u.group.Group("/users", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeUsersRead))
+	group.GET("/export", middlewares.Authorize(constants.ActionUserExport, constants.ResourceUser), u.controller.GetUserExportController().Export)
+}
@@ -0,0 +1,27 @@
+package userimport
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UserImportRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IUserImportRoute interface {
+	Run()
+}
+
+func NewUserImportRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IUserImportRoute {
+	return &UserImportRoute{controller: controller, group: group}
+}
+
+func (u *UserImportRoute) Run() {
+	group := u.group.Group("/users", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeUsersWrite))
+	group.POST("/import", middlewares.Authorize(constants.ActionUserImport, constants.ResourceUser), u.controller.GetUserImportController().Import)
+}
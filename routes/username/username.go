@@ -0,0 +1,27 @@
+package username
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UsernameRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IUsernameRoute interface {
+	Run()
+}
+
+func NewUsernameRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IUsernameRoute {
+	return &UsernameRoute{controller: controller, group: group}
+}
+
+func (u *UsernameRoute) Run() {
+	admin := u.group.Group("/users/:uuid/username-history", middlewares.Authenticate(), middlewares.RequireScope(constants.ScopeUsersRead))
+	admin.GET("", middlewares.Authorize(constants.ActionUserList, constants.ResourceUser), u.controller.GetUsernameController().History)
+}
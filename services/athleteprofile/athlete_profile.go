@@ -0,0 +1,205 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"user-service/common/policy"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+	guardianServices "user-service/services/guardian"
+
+	"github.com/google/uuid"
+)
+
+type AthleteProfileService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IAthleteProfileService interface {
+	Get(context.Context, string) (*dto.AthleteProfileResponse, error)
+	Create(context.Context, *dto.AthleteProfileRequest, string) (*dto.AthleteProfileResponse, error)
+	Update(context.Context, *dto.AthleteProfileRequest, string) (*dto.AthleteProfileResponse, error)
+	Delete(context.Context, string) error
+}
+
+func NewAthleteProfileService(repository repositories.IRepositoryRegistry) IAthleteProfileService {
+	return &AthleteProfileService{
+		repository: repository,
+	}
+}
+
+func (a *AthleteProfileService) Get(ctx context.Context, uuid string) (*dto.AthleteProfileResponse, error) {
+	user, err := a.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := a.repository.GetAthleteProfile().FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToAthleteProfileResponse(profile), nil
+}
+
+func (a *AthleteProfileService) Create(ctx context.Context, req *dto.AthleteProfileRequest, userUUID string) (*dto.AthleteProfileResponse, error) {
+	user, err := a.repository.GetUser().FindByUUID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	err = guardianServices.CheckChange(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = a.repository.GetAthleteProfile().FindByUserID(ctx, user.ID)
+	if err == nil {
+		return nil, errConstant.ErrAthleteProfileExists
+	}
+	if !errors.Is(err, errConstant.ErrAthleteProfileNotFound) {
+		return nil, err
+	}
+
+	sports, err := toAthleteSports(ctx, req, userUUID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &models.AthleteProfile{
+		UUID:         uuid.New(),
+		UserID:       user.ID,
+		Sports:       sports,
+		DominantHand: req.DominantHand,
+		DominantFoot: req.DominantFoot,
+		HeightCm:     req.HeightCm,
+		WeightKg:     req.WeightKg,
+	}
+	err = a.repository.GetAthleteProfile().Create(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToAthleteProfileResponse(profile), nil
+}
+
+func (a *AthleteProfileService) Update(ctx context.Context, req *dto.AthleteProfileRequest, userUUID string) (*dto.AthleteProfileResponse, error) {
+	user, err := a.repository.GetUser().FindByUUID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	err = guardianServices.CheckChange(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := a.repository.GetAthleteProfile().FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	sports, err := toAthleteSports(ctx, req, userUUID, profile.Sports)
+	if err != nil {
+		return nil, err
+	}
+
+	profile.Sports = sports
+	profile.DominantHand = req.DominantHand
+	profile.DominantFoot = req.DominantFoot
+	profile.HeightCm = req.HeightCm
+	profile.WeightKg = req.WeightKg
+	err = a.repository.GetAthleteProfile().Update(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToAthleteProfileResponse(profile), nil
+}
+
+func (a *AthleteProfileService) Delete(ctx context.Context, uuid string) error {
+	user, err := a.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+	err = guardianServices.CheckChange(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.repository.GetAthleteProfile().FindByUserID(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	return a.repository.GetAthleteProfile().DeleteByUserID(ctx, user.ID)
+}
+
+// toAthleteSports keeps a previously verified skill level as verified as long as
+// the level itself is unchanged, so owners can edit positions without losing it.
+func toAthleteSports(ctx context.Context, req *dto.AthleteProfileRequest, userUUID string, previous []models.AthleteSport) ([]models.AthleteSport, error) {
+	verified := make(map[string]string, len(previous))
+	for _, item := range previous {
+		if item.SkillSource == constants.SkillSourceVerified {
+			verified[strings.ToLower(item.Sport)] = item.SkillLevel
+		}
+	}
+
+	sports := make([]models.AthleteSport, 0, len(req.Sports))
+	seen := make(map[string]bool, len(req.Sports))
+	for _, item := range req.Sports {
+		sport := strings.TrimSpace(item.Sport)
+		key := strings.ToLower(sport)
+		if seen[key] {
+			return nil, errConstant.ErrDuplicateSport
+		}
+		seen[key] = true
+
+		source := constants.SkillSourceSelfAssessed
+		if item.Verified {
+			err := policy.Authorize(ctx, constants.ActionUserVerifySkill, policy.Attributes{
+				"type": constants.ResourceUser,
+				"uuid": userUUID,
+			})
+			if err != nil {
+				return nil, err
+			}
+			source = constants.SkillSourceVerified
+		} else if level, ok := verified[key]; ok && level == item.SkillLevel {
+			source = constants.SkillSourceVerified
+		}
+
+		sports = append(sports, models.AthleteSport{
+			Sport:       sport,
+			SkillLevel:  item.SkillLevel,
+			SkillSource: source,
+			Positions:   item.Positions,
+		})
+	}
+
+	return sports, nil
+}
+
+func ToAthleteProfileResponse(profile *models.AthleteProfile) *dto.AthleteProfileResponse {
+	sports := make([]dto.AthleteSportResponse, 0, len(profile.Sports))
+	for _, item := range profile.Sports {
+		sports = append(sports, dto.AthleteSportResponse{
+			Sport:       item.Sport,
+			SkillLevel:  item.SkillLevel,
+			SkillSource: item.SkillSource,
+			Positions:   item.Positions,
+		})
+	}
+
+	return &dto.AthleteProfileResponse{
+		UUID:         profile.UUID,
+		Sports:       sports,
+		DominantHand: profile.DominantHand,
+		DominantFoot: profile.DominantFoot,
+		HeightCm:     profile.HeightCm,
+		WeightKg:     profile.WeightKg,
+		UpdatedAt:    profile.UpdatedAt,
+	}
+}
@@ -0,0 +1,388 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	errWrap "user-service/common/error"
+	"user-service/common/policy"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+	guardianServices "user-service/services/guardian"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+const dateLayout = "2006-01-02"
+
+var numericRules = []string{"min", "max", "gte", "lte"}
+
+type AttributeService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IAttributeService interface {
+	GetDefinitions(context.Context) ([]dto.AttributeDefinitionResponse, error)
+	CreateDefinition(context.Context, *dto.AttributeDefinitionRequest) (*dto.AttributeDefinitionResponse, error)
+	UpdateDefinition(context.Context, *dto.AttributeDefinitionRequest, string) (*dto.AttributeDefinitionResponse, error)
+	DeleteDefinition(context.Context, string) error
+	SaveValues(context.Context, map[string]any, string) (map[string]any, error)
+}
+
+func NewAttributeService(repository repositories.IRepositoryRegistry) IAttributeService {
+	return &AttributeService{
+		repository: repository,
+	}
+}
+
+// GetDefinitions lists the definitions the caller may see; admin-only
+// definitions are hidden from everyone else.
+func (a *AttributeService) GetDefinitions(ctx context.Context) ([]dto.AttributeDefinitionResponse, error) {
+	definitions, err := a.repository.GetAttribute().FindAllDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	admin := isAdmin(ctx)
+	response := make([]dto.AttributeDefinitionResponse, 0, len(definitions))
+	for i := range definitions {
+		if definitions[i].Visibility == constants.AttributeVisibilityAdmin && !admin {
+			continue
+		}
+		response = append(response, *toDefinitionResponse(&definitions[i]))
+	}
+
+	return response, nil
+}
+
+func (a *AttributeService) CreateDefinition(ctx context.Context, req *dto.AttributeDefinitionRequest) (*dto.AttributeDefinitionResponse, error) {
+	err := validateDefinition(req)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := a.repository.GetAttribute().ExistsByKey(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errConstant.ErrAttributeKeyExists
+	}
+
+	definition := &models.AttributeDefinition{
+		UUID:       uuid.New(),
+		Key:        req.Key,
+		Label:      req.Label,
+		Type:       req.Type,
+		Required:   req.Required,
+		Visibility: req.Visibility,
+		Options:    req.Options,
+		Rules:      req.Rules,
+	}
+	err = a.repository.GetAttribute().CreateDefinition(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDefinitionResponse(definition), nil
+}
+
+// UpdateDefinition keeps the key and type of an existing definition so that
+// stored values stay readable.
+func (a *AttributeService) UpdateDefinition(ctx context.Context, req *dto.AttributeDefinitionRequest, uuid string) (*dto.AttributeDefinitionResponse, error) {
+	definition, err := a.repository.GetAttribute().FindDefinitionByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Key = definition.Key
+	req.Type = definition.Type
+	err = validateDefinition(req)
+	if err != nil {
+		return nil, err
+	}
+
+	definition.Label = req.Label
+	definition.Required = req.Required
+	definition.Visibility = req.Visibility
+	definition.Options = req.Options
+	definition.Rules = req.Rules
+	err = a.repository.GetAttribute().UpdateDefinition(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDefinitionResponse(definition), nil
+}
+
+func (a *AttributeService) DeleteDefinition(ctx context.Context, uuid string) error {
+	definition, err := a.repository.GetAttribute().FindDefinitionByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return a.repository.GetAttribute().DeleteDefinition(ctx, definition)
+}
+
+// SaveValues merges changes into the user's stored values. A null value
+// removes the attribute. Validation failures are returned as
+// validator.ValidationErrors keyed by attribute.
+func (a *AttributeService) SaveValues(ctx context.Context, changes map[string]any, userUUID string) (map[string]any, error) {
+	user, err := a.repository.GetUser().FindByUUID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	err = guardianServices.CheckChange(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions, err := a.repository.GetAttribute().FindAllDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := a.repository.GetAttribute().FindValues(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]any, len(stored))
+	for i := range stored {
+		current[stored[i].Definition.Key] = stored[i].Value
+	}
+
+	admin := isAdmin(ctx)
+	var (
+		fieldErrors validator.ValidationErrors
+		values      []models.UserAttributeValue
+		removed     []uint
+	)
+	for key := range changes {
+		if !slices.ContainsFunc(definitions, func(definition models.AttributeDefinition) bool {
+			return definition.Key == key && canEdit(&definition, admin)
+		}) {
+			fieldErrors = append(fieldErrors, &errWrap.FieldError{Name: key, Rule: "unknown"})
+		}
+	}
+
+	for i := range definitions {
+		definition := &definitions[i]
+		if !canEdit(definition, admin) {
+			continue
+		}
+
+		value, changed := changes[definition.Key]
+		if !changed {
+			value = current[definition.Key]
+		}
+
+		if value == nil {
+			if definition.Required {
+				fieldErrors = append(fieldErrors, &errWrap.FieldError{Name: definition.Key, Rule: "required"})
+			} else if changed {
+				removed = append(removed, definition.ID)
+			}
+			continue
+		}
+		if !changed {
+			continue
+		}
+
+		normalized, errs := validateValue(definition, value)
+		if len(errs) > 0 {
+			fieldErrors = append(fieldErrors, errs...)
+			continue
+		}
+		current[definition.Key] = normalized
+		values = append(values, models.UserAttributeValue{
+			UserID:       user.ID,
+			DefinitionID: definition.ID,
+			Value:        normalized,
+		})
+	}
+	if len(fieldErrors) > 0 {
+		return nil, fieldErrors
+	}
+
+	err = a.repository.GetAttribute().SaveValues(ctx, user.ID, values, removed)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err = a.repository.GetAttribute().FindValues(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Visible(ctx, stored, userUUID), nil
+}
+
+// Visible returns the values of ownerUUID that the caller is allowed to see,
+// keyed by attribute.
+func Visible(ctx context.Context, values []models.UserAttributeValue, ownerUUID string) map[string]any {
+	if len(values) == 0 {
+		return nil
+	}
+
+	admin := isAdmin(ctx)
+	owner := policy.SubjectFromContext(ctx)["uuid"] == ownerUUID
+	visible := make(map[string]any, len(values))
+	for i := range values {
+		switch values[i].Definition.Visibility {
+		case constants.AttributeVisibilityPublic:
+		case constants.AttributeVisibilityPrivate:
+			if !owner && !admin {
+				continue
+			}
+		default:
+			if !admin {
+				continue
+			}
+		}
+		visible[values[i].Definition.Key] = values[i].Value
+	}
+
+	return visible
+}
+
+func isAdmin(ctx context.Context) bool {
+	return policy.Authorize(ctx, constants.ActionAttributeManage, policy.Attributes{
+		"type": constants.ResourceAttribute,
+	}) == nil
+}
+
+func canEdit(definition *models.AttributeDefinition, admin bool) bool {
+	return admin || definition.Visibility != constants.AttributeVisibilityAdmin
+}
+
+// validateDefinition only accepts whitelisted rule tags, with integer
+// parameters unless the type is number, so that a definition can never make
+// validator.Var panic at write time.
+func validateDefinition(req *dto.AttributeDefinitionRequest) error {
+	if req.Type == constants.AttributeTypeEnum && len(req.Options) == 0 {
+		return errConstant.ErrAttributeOptionsEmpty
+	}
+	if req.Type != constants.AttributeTypeEnum {
+		req.Options = nil
+	}
+
+	req.Rules = strings.TrimSpace(req.Rules)
+	if req.Rules == "" {
+		return nil
+	}
+	if req.Type == constants.AttributeTypeBoolean {
+		return fmt.Errorf("%w: %s", errConstant.ErrInvalidAttributeRule, req.Rules)
+	}
+
+	for _, rule := range strings.Split(req.Rules, ",") {
+		tag, param, hasParam := strings.Cut(rule, "=")
+		allowed := constants.AttributeRules
+		if req.Type == constants.AttributeTypeInteger || req.Type == constants.AttributeTypeNumber {
+			allowed = numericRules
+		}
+		if !slices.Contains(allowed, tag) {
+			return fmt.Errorf("%w: %s", errConstant.ErrInvalidAttributeRule, rule)
+		}
+
+		needsParam := slices.Contains([]string{"min", "max", "len", "gte", "lte"}, tag)
+		if needsParam != hasParam {
+			return fmt.Errorf("%w: %s", errConstant.ErrInvalidAttributeRule, rule)
+		}
+		if hasParam {
+			var err error
+			if req.Type == constants.AttributeTypeNumber {
+				_, err = strconv.ParseFloat(param, 64)
+			} else {
+				_, err = strconv.Atoi(param)
+			}
+			if err != nil {
+				return fmt.Errorf("%w: %s", errConstant.ErrInvalidAttributeRule, rule)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateValue(definition *models.AttributeDefinition, value any) (any, validator.ValidationErrors) {
+	typeError := validator.ValidationErrors{&errWrap.FieldError{
+		Name:      definition.Key,
+		Rule:      "type",
+		Parameter: definition.Type,
+	}}
+
+	var normalized any
+	switch definition.Type {
+	case constants.AttributeTypeString, constants.AttributeTypeDate, constants.AttributeTypeEnum:
+		text, ok := value.(string)
+		if !ok {
+			return nil, typeError
+		}
+		normalized = strings.TrimSpace(text)
+	case constants.AttributeTypeInteger:
+		number, ok := value.(float64)
+		if !ok || number != math.Trunc(number) {
+			return nil, typeError
+		}
+		normalized = int64(number)
+	case constants.AttributeTypeNumber:
+		number, ok := value.(float64)
+		if !ok {
+			return nil, typeError
+		}
+		normalized = number
+	case constants.AttributeTypeBoolean:
+		flag, ok := value.(bool)
+		if !ok {
+			return nil, typeError
+		}
+		normalized = flag
+	}
+
+	validate := validator.New()
+	switch definition.Type {
+	case constants.AttributeTypeDate:
+		err := validate.Var(normalized, "datetime="+dateLayout)
+		if err != nil {
+			return nil, errWrap.NameFieldErrors(definition.Key, err)
+		}
+	case constants.AttributeTypeEnum:
+		if !slices.Contains(definition.Options, normalized.(string)) {
+			return nil, validator.ValidationErrors{&errWrap.FieldError{
+				Name:      definition.Key,
+				Rule:      "oneof",
+				Parameter: strings.Join(definition.Options, " "),
+			}}
+		}
+	}
+
+	if definition.Rules != "" {
+		err := validate.Var(normalized, definition.Rules)
+		if err != nil {
+			return nil, errWrap.NameFieldErrors(definition.Key, err)
+		}
+	}
+
+	return normalized, nil
+}
+
+func toDefinitionResponse(definition *models.AttributeDefinition) *dto.AttributeDefinitionResponse {
+	return &dto.AttributeDefinitionResponse{
+		UUID:       definition.UUID,
+		Key:        definition.Key,
+		Label:      definition.Label,
+		Type:       definition.Type,
+		Required:   definition.Required,
+		Visibility: definition.Visibility,
+		Options:    definition.Options,
+		Rules:      definition.Rules,
+	}
+}
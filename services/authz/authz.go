@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"user-service/common/policy"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/repositories"
+	guardianServices "user-service/services/guardian"
+	suspensionServices "user-service/services/suspension"
+)
+
+type AuthzService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IAuthzService interface {
+	Check(context.Context, *dto.AuthzCheckRequest) (*dto.AuthzDecisionResponse, error)
+	BatchCheck(context.Context, *dto.AuthzBatchCheckRequest) (*dto.AuthzBatchDecisionResponse, error)
+}
+
+func NewAuthzService(repository repositories.IRepositoryRegistry) IAuthzService {
+	return &AuthzService{
+		repository: repository,
+	}
+}
+
+func (a *AuthzService) Check(ctx context.Context, req *dto.AuthzCheckRequest) (*dto.AuthzDecisionResponse, error) {
+	subject, err := a.subject(ctx, &req.Subject)
+	if err != nil {
+		if errors.Is(err, errConstant.ErrNotFound) {
+			return &dto.AuthzDecisionResponse{
+				Allowed: false,
+				Effect:  policy.EffectDeny,
+				Reason:  "subject not found",
+			}, nil
+		}
+
+		return nil, err
+	}
+
+	resource := policy.Attributes{}
+	for key, value := range req.Resource.Attributes {
+		resource[key] = value
+	}
+	resource["type"] = req.Resource.Type
+
+	decision := policy.Evaluate(policy.Input{
+		Subject:  subject,
+		Action:   req.Action,
+		Resource: resource,
+	})
+
+	response := &dto.AuthzDecisionResponse{
+		Allowed:  decision.Allowed,
+		Effect:   decision.Effect,
+		Reason:   decision.Reason,
+		PolicyID: decision.PolicyID,
+		Version:  decision.Version,
+	}
+
+	return response, nil
+}
+
+func (a *AuthzService) BatchCheck(ctx context.Context, req *dto.AuthzBatchCheckRequest) (*dto.AuthzBatchDecisionResponse, error) {
+	decisions := make([]dto.AuthzDecisionResponse, 0, len(req.Checks))
+	for i := range req.Checks {
+		decision, err := a.Check(ctx, &req.Checks[i])
+		if err != nil {
+			return nil, err
+		}
+
+		decisions = append(decisions, *decision)
+	}
+
+	response := &dto.AuthzBatchDecisionResponse{
+		Decisions: decisions,
+	}
+
+	return response, nil
+}
+
+func (a *AuthzService) subject(ctx context.Context, req *dto.AuthzSubject) (policy.Attributes, error) {
+	// Identity attributes only ever come from the stored user, never from the
+	// caller, so a check cannot claim another role or uuid.
+	reserved := policy.SubjectFromUser(&dto.UserResponse{})
+	subject := policy.Attributes{}
+	for key, value := range req.Attributes {
+		if _, ok := reserved[key]; !ok {
+			subject[key] = value
+		}
+	}
+
+	if req.UUID == "" {
+		return subject, nil
+	}
+
+	user, err := a.repository.GetUser().FindByUUID(ctx, req.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	// An expired suspension is lifted here so the subject reports the status
+	// the user would log in with.
+	if user.Status == constants.UserStatusSuspended {
+		err = suspensionServices.Check(ctx, a.repository, user)
+		if err != nil && !errors.Is(err, errConstant.ErrUserSuspended) {
+			return nil, err
+		}
+	}
+
+	for key, value := range policy.SubjectFromUser(&dto.UserResponse{
+		UUID:     user.UUID,
+		Username: user.Username,
+		Email:    user.Email,
+		Role:     strings.ToLower(user.Role.Code),
+		Status:   user.Status,
+		Minor:    guardianServices.IsMinor(user.DateOfBirth),
+	}) {
+		subject[key] = value
+	}
+
+	return subject, nil
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"slices"
+	"time"
+	"user-service/common/imaging"
+	"user-service/common/storage"
+	"user-service/config"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/repositories"
+	guardianServices "user-service/services/guardian"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultMaxDimension = 6000
+	jpegQuality         = 85
+)
+
+var allowedMimeTypes = []string{"image/jpeg", "image/png", "image/gif"}
+
+type AvatarService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IAvatarService interface {
+	Upload(context.Context, io.Reader) (*dto.AvatarResponse, error)
+	Delete(context.Context) error
+}
+
+func NewAvatarService(repository repositories.IRepositoryRegistry) IAvatarService {
+	return &AvatarService{
+		repository: repository,
+	}
+}
+
+func (a *AvatarService) Upload(ctx context.Context, reader io.Reader) (*dto.AvatarResponse, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	user, err := a.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+	err = guardianServices.CheckChange(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errConstant.ErrAvatarTooLarge
+	}
+
+	mime := mimetype.Detect(content)
+	if !slices.ContainsFunc(allowedMimeTypes, mime.Is) {
+		return nil, fmt.Errorf("%w: %s", errConstant.ErrUnsupportedImageType, mime.String())
+	}
+
+	imageConfig, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, errConstant.ErrInvalidImage
+	}
+
+	maxDimension := config.Config.Avatar.MaxDimension
+	if maxDimension <= 0 {
+		maxDimension = defaultMaxDimension
+	}
+	if imageConfig.Width > maxDimension || imageConfig.Height > maxDimension {
+		return nil, fmt.Errorf("%w: dimensions exceed %dpx", errConstant.ErrInvalidImage, maxDimension)
+	}
+
+	source, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, errConstant.ErrInvalidImage
+	}
+
+	avatarPath := fmt.Sprintf("avatars/%s/%d", user.UUID, time.Now().UnixNano())
+	for name, size := range constants.AvatarSizes {
+		var buffer bytes.Buffer
+		err = jpeg.Encode(&buffer, imaging.Thumbnail(source, size), &jpeg.Options{Quality: jpegQuality})
+		if err != nil {
+			return nil, err
+		}
+
+		err = storage.Default().Put(ctx, avatarKey(avatarPath, name), &buffer)
+		if err != nil {
+			RemoveFiles(ctx, avatarPath)
+			return nil, err
+		}
+	}
+
+	err = a.repository.GetUser().UpdateAvatar(ctx, user.UUID.String(), avatarPath)
+	if err != nil {
+		RemoveFiles(ctx, avatarPath)
+		return nil, err
+	}
+	RemoveFiles(ctx, user.AvatarPath)
+
+	avatarURL, avatarURLs := URLs(avatarPath)
+
+	return &dto.AvatarResponse{
+		AvatarURL:  *avatarURL,
+		AvatarURLs: avatarURLs,
+	}, nil
+}
+
+func (a *AvatarService) Delete(ctx context.Context) error {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	user, err := a.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+	if err != nil {
+		return err
+	}
+	err = guardianServices.CheckChange(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	if user.AvatarPath == "" {
+		return errConstant.ErrAvatarNotFound
+	}
+
+	err = a.repository.GetUser().UpdateAvatar(ctx, user.UUID.String(), "")
+	if err != nil {
+		return err
+	}
+	RemoveFiles(ctx, user.AvatarPath)
+
+	return nil
+}
+
+func URLs(avatarPath string) (*string, map[string]string) {
+	if avatarPath == "" {
+		return nil, nil
+	}
+
+	urls := make(map[string]string, len(constants.AvatarSizes))
+	for name := range constants.AvatarSizes {
+		urls[name] = storage.Default().URL(avatarKey(avatarPath, name))
+	}
+	avatarURL := urls[constants.AvatarSizeMedium]
+
+	return &avatarURL, urls
+}
+
+func RemoveFiles(ctx context.Context, avatarPath string) {
+	if avatarPath == "" {
+		return
+	}
+
+	for name := range constants.AvatarSizes {
+		err := storage.Default().Delete(ctx, avatarKey(avatarPath, name))
+		if err != nil {
+			logrus.Errorf("failed to remove avatar %s: %v", avatarKey(avatarPath, name), err)
+		}
+	}
+}
+
+func avatarKey(avatarPath, size string) string {
+	return fmt.Sprintf("%s/%s.jpg", avatarPath, size)
+}
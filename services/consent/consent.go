@@ -0,0 +1,267 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+	"user-service/common/util"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+
+	"github.com/google/uuid"
+)
+
+type ConsentService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IConsentService interface {
+	GetCurrent(context.Context) ([]dto.ConsentDocumentResponse, error)
+	ListDocuments(context.Context) ([]dto.ConsentDocumentResponse, error)
+	Publish(context.Context, *dto.ConsentDocumentRequest) (*dto.ConsentDocumentResponse, error)
+	GetMine(context.Context) ([]dto.ConsentDocumentResponse, error)
+	Accept(context.Context, *dto.ConsentAcceptRequest, *dto.RequestMeta) ([]dto.ConsentDocumentResponse, error)
+	Report(context.Context) ([]dto.ConsentReportResponse, error)
+}
+
+func NewConsentService(repository repositories.IRepositoryRegistry) IConsentService {
+	return &ConsentService{
+		repository: repository,
+	}
+}
+
+func (c *ConsentService) GetCurrent(ctx context.Context) ([]dto.ConsentDocumentResponse, error) {
+	documents, err := c.repository.GetConsent().FindCurrent(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return toDocumentResponses(documents, nil), nil
+}
+
+func (c *ConsentService) ListDocuments(ctx context.Context) ([]dto.ConsentDocumentResponse, error) {
+	documents, err := c.repository.GetConsent().FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDocumentResponses(documents, nil), nil
+}
+
+// Publish adds a new document version. Once its publication time has passed
+// every user has to accept it again before getting a full-scope token.
+func (c *ConsentService) Publish(ctx context.Context, req *dto.ConsentDocumentRequest) (*dto.ConsentDocumentResponse, error) {
+	exists, err := c.repository.GetConsent().ExistsByVersion(ctx, req.Type, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errConstant.ErrConsentVersionExists
+	}
+
+	publishedAt := time.Now()
+	if req.PublishedAt != nil {
+		publishedAt = *req.PublishedAt
+	}
+
+	document := &models.ConsentDocument{
+		UUID:        uuid.New(),
+		Type:        req.Type,
+		Version:     req.Version,
+		Title:       req.Title,
+		URL:         req.URL,
+		PublishedAt: publishedAt,
+	}
+	err = c.repository.GetConsent().Create(ctx, document)
+	if err != nil {
+		return nil, err
+	}
+
+	return &toDocumentResponses([]models.ConsentDocument{*document}, nil)[0], nil
+}
+
+func (c *ConsentService) GetMine(ctx context.Context) ([]dto.ConsentDocumentResponse, error) {
+	user, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, err := c.repository.GetConsent().FindCurrent(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	accepted, err := c.acceptedAt(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDocumentResponses(documents, accepted), nil
+}
+
+func (c *ConsentService) Accept(ctx context.Context, req *dto.ConsentAcceptRequest, meta *dto.RequestMeta) ([]dto.ConsentDocumentResponse, error) {
+	user, err := c.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, err := c.repository.GetConsent().FindByUUIDs(ctx, req.Documents)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	acceptances := make([]models.ConsentAcceptance, 0, len(documents))
+	for _, document := range documents {
+		if document.PublishedAt.After(now) {
+			return nil, errConstant.ErrConsentDocumentNotFound
+		}
+
+		acceptances = append(acceptances, models.ConsentAcceptance{
+			UserID:     user.ID,
+			DocumentID: document.ID,
+			IPAddress:  meta.IPAddress,
+			UserAgent:  util.Truncate(meta.UserAgent, 255),
+			AcceptedAt: now,
+		})
+	}
+	if len(acceptances) == 0 || len(acceptances) != len(uniqueUUIDs(req.Documents)) {
+		return nil, errConstant.ErrConsentDocumentNotFound
+	}
+
+	err = c.repository.GetConsent().Accept(ctx, acceptances)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetMine(ctx)
+}
+
+// Report gives, for every document, the share of active users who accepted
+// it.
+func (c *ConsentService) Report(ctx context.Context) ([]dto.ConsentReportResponse, error) {
+	documents, err := c.repository.GetConsent().FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.repository.GetConsent().FindCurrent(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	activeUsers, err := c.repository.GetConsent().CountActiveUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := c.repository.GetConsent().CountAcceptances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	currentIDs := make(map[uint]bool, len(current))
+	for _, document := range current {
+		currentIDs[document.ID] = true
+	}
+
+	responses := toDocumentResponses(documents, nil)
+	report := make([]dto.ConsentReportResponse, 0, len(documents))
+	for i, document := range documents {
+		rate := 0.0
+		if activeUsers > 0 {
+			rate = float64(counts[document.ID]) / float64(activeUsers)
+		}
+
+		report = append(report, dto.ConsentReportResponse{
+			Document:       responses[i],
+			Current:        currentIDs[document.ID],
+			ActiveUsers:    activeUsers,
+			AcceptedUsers:  counts[document.ID],
+			AcceptanceRate: rate,
+		})
+	}
+
+	return report, nil
+}
+
+// Pending returns the current documents userID has not accepted yet.
+func Pending(ctx context.Context, repository repositories.IRepositoryRegistry, userID uint) ([]dto.ConsentDocumentResponse, error) {
+	documents, err := repository.GetConsent().FindCurrent(ctx, time.Now())
+	if err != nil || len(documents) == 0 {
+		return nil, err
+	}
+
+	acceptances, err := repository.GetConsent().FindAcceptances(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	accepted := make(map[uint]bool, len(acceptances))
+	for _, acceptance := range acceptances {
+		accepted[acceptance.DocumentID] = true
+	}
+
+	var pending []models.ConsentDocument
+	for _, document := range documents {
+		if !accepted[document.ID] {
+			pending = append(pending, document)
+		}
+	}
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	return toDocumentResponses(pending, nil), nil
+}
+
+func (c *ConsentService) currentUser(ctx context.Context) (*models.User, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	return c.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+}
+
+func (c *ConsentService) acceptedAt(ctx context.Context, userID uint) (map[uint]time.Time, error) {
+	acceptances, err := c.repository.GetConsent().FindAcceptances(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	accepted := make(map[uint]time.Time, len(acceptances))
+	for _, acceptance := range acceptances {
+		accepted[acceptance.DocumentID] = acceptance.AcceptedAt
+	}
+
+	return accepted, nil
+}
+
+func uniqueUUIDs(uuids []string) map[string]bool {
+	unique := make(map[string]bool, len(uuids))
+	for _, item := range uuids {
+		unique[strings.ToLower(item)] = true
+	}
+
+	return unique
+}
+
+func toDocumentResponses(documents []models.ConsentDocument, accepted map[uint]time.Time) []dto.ConsentDocumentResponse {
+	responses := make([]dto.ConsentDocumentResponse, 0, len(documents))
+	for _, document := range documents {
+		response := dto.ConsentDocumentResponse{
+			UUID:        document.UUID,
+			Type:        document.Type,
+			Version:     document.Version,
+			Title:       document.Title,
+			URL:         document.URL,
+			PublishedAt: document.PublishedAt,
+		}
+		if acceptedAt, ok := accepted[document.ID]; ok {
+			response.AcceptedAt = &acceptedAt
+		}
+		responses = append(responses, response)
+	}
+
+	return responses
+}
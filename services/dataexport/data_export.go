@@ -0,0 +1,523 @@
+package services
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+	"user-service/config"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+	emergencyInfoServices "user-service/services/emergencyinfo"
+	guardianServices "user-service/services/guardian"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultStoragePath          = "storage/exports"
+	defaultLinkExpirationMinute = 15
+	defaultRetentionHour        = 72
+	defaultBatchSize            = 10
+	defaultStaleMinute          = 30
+)
+
+type DataExportService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IDataExportService interface {
+	Request(context.Context) (*dto.DataExportResponse, error)
+	GetByUUID(context.Context, string) (*dto.DataExportResponse, error)
+	Download(context.Context, string, *dto.DataExportDownloadRequest) (string, error)
+	ProcessPending(context.Context) (int, error)
+	PurgeExpired(context.Context) (int, error)
+}
+
+type section struct {
+	Name    string
+	Records []map[string]any
+}
+
+func NewDataExportService(repository repositories.IRepositoryRegistry) IDataExportService {
+	return &DataExportService{
+		repository: repository,
+	}
+}
+
+func (d *DataExportService) Request(ctx context.Context) (*dto.DataExportResponse, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	user, err := d.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := d.repository.GetDataExport().Create(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDataExportResponse(export, nil), nil
+}
+
+func (d *DataExportService) GetByUUID(ctx context.Context, uuid string) (*dto.DataExportResponse, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	export, err := d.repository.GetDataExport().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	if export.User.UUID != userLogin.UUID {
+		return nil, errConstant.ErrDataExportNotFound
+	}
+
+	if export.Status != constants.DataExportStatusReady {
+		return toDataExportResponse(export, nil), nil
+	}
+
+	expires := time.Now().Add(time.Duration(linkExpirationMinute()) * time.Minute).Unix()
+	downloadURL := fmt.Sprintf("%s/api/v1/exports/%s/download?expires=%d&signature=%s",
+		config.Config.DataExport.BaseURL,
+		export.UUID,
+		expires,
+		sign(export.UUID.String(), expires),
+	)
+
+	return toDataExportResponse(export, &downloadURL), nil
+}
+
+func (d *DataExportService) Download(ctx context.Context, uuid string, req *dto.DataExportDownloadRequest) (string, error) {
+	if !hmac.Equal([]byte(sign(uuid, req.Expires)), []byte(req.Signature)) {
+		return "", errConstant.ErrInvalidSignature
+	}
+
+	if time.Now().Unix() > req.Expires {
+		return "", errConstant.ErrLinkExpired
+	}
+
+	export, err := d.repository.GetDataExport().FindByUUID(ctx, uuid)
+	if err != nil {
+		return "", err
+	}
+
+	if export.Status != constants.DataExportStatusReady {
+		return "", errConstant.ErrDataExportNotReady
+	}
+
+	return export.FilePath, nil
+}
+
+func (d *DataExportService) ProcessPending(ctx context.Context) (int, error) {
+	now := time.Now()
+	staleBefore := now.Add(-time.Duration(staleMinute()) * time.Minute)
+	exports, err := d.repository.GetDataExport().Claim(ctx, now, staleBefore, defaultBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range exports {
+		export := &exports[i]
+		filePath, err := d.buildArchive(ctx, export)
+		if err != nil {
+			logrus.Errorf("failed to build data export %s: %v", export.UUID, err)
+			export.Status = constants.DataExportStatusFailed
+			export.Error = err.Error()
+		} else {
+			now := time.Now()
+			expiresAt := now.Add(time.Duration(retentionHour()) * time.Hour)
+			export.Status = constants.DataExportStatusReady
+			export.FilePath = filePath
+			export.CompletedAt = &now
+			export.ExpiresAt = &expiresAt
+		}
+
+		err = d.repository.GetDataExport().Update(ctx, export)
+		if err != nil {
+			return i, err
+		}
+	}
+
+	return len(exports), nil
+}
+
+func (d *DataExportService) PurgeExpired(ctx context.Context) (int, error) {
+	exports, err := d.repository.GetDataExport().FindExpired(ctx, time.Now(), defaultBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range exports {
+		export := &exports[i]
+		err = os.Remove(export.FilePath)
+		if err != nil && !os.IsNotExist(err) {
+			return i, err
+		}
+
+		export.Status = constants.DataExportStatusExpired
+		export.FilePath = ""
+		err = d.repository.GetDataExport().Update(ctx, export)
+		if err != nil {
+			return i, err
+		}
+	}
+
+	return len(exports), nil
+}
+
+func (d *DataExportService) buildArchive(ctx context.Context, export *models.DataExport) (string, error) {
+	sections, err := d.collectSections(ctx, &export.User)
+	if err != nil {
+		return "", err
+	}
+
+	storagePath := config.Config.DataExport.StoragePath
+	if storagePath == "" {
+		storagePath = defaultStoragePath
+	}
+
+	err = os.MkdirAll(storagePath, 0o700)
+	if err != nil {
+		return "", err
+	}
+
+	filePath := filepath.Join(storagePath, fmt.Sprintf("%s.zip", export.UUID))
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	archive := zip.NewWriter(file)
+	err = writeSections(archive, sections)
+	if err != nil {
+		archive.Close()
+		os.Remove(filePath)
+		return "", err
+	}
+
+	err = archive.Close()
+	if err != nil {
+		os.Remove(filePath)
+		return "", err
+	}
+
+	return filePath, nil
+}
+
+func (d *DataExportService) collectSections(ctx context.Context, user *models.User) ([]section, error) {
+	sections := []section{
+		{
+			Name: "profile",
+			Records: []map[string]any{
+				{
+					"uuid":              user.UUID.String(),
+					"name":              user.Name,
+					"username":          user.Username,
+					"email":             user.Email,
+					"phone_number":      user.PhoneNumber,
+					"phone_verified_at": formatTime(user.PhoneVerifiedAt),
+					"avatar_path":       user.AvatarPath,
+					"date_of_birth":     valueOf(guardianServices.FormatDateOfBirth(user.DateOfBirth)),
+					"status":            user.Status,
+					"created_at":        formatTime(user.CreatedAt),
+					"updated_at":        formatTime(user.UpdatedAt),
+				},
+			},
+		},
+		{
+			Name: "role",
+			Records: []map[string]any{
+				{
+					"code": user.Role.Code,
+					"name": user.Role.Name,
+				},
+			},
+		},
+	}
+
+	profile, err := d.repository.GetAthleteProfile().FindByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, errConstant.ErrAthleteProfileNotFound) {
+		return nil, err
+	}
+	if profile != nil {
+		records := make([]map[string]any, 0, len(profile.Sports))
+		for _, item := range profile.Sports {
+			records = append(records, map[string]any{
+				"sport":         item.Sport,
+				"skill_level":   item.SkillLevel,
+				"skill_source":  item.SkillSource,
+				"positions":     strings.Join(item.Positions, ";"),
+				"dominant_hand": profile.DominantHand,
+				"dominant_foot": profile.DominantFoot,
+				"height_cm":     valueOf(profile.HeightCm),
+				"weight_kg":     valueOf(profile.WeightKg),
+			})
+		}
+		sections = append(sections, section{Name: "athlete_profile", Records: records})
+	}
+
+	info, err := d.repository.GetEmergencyInfo().FindByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, errConstant.ErrEmergencyInfoNotFound) {
+		return nil, err
+	}
+	if info != nil {
+		emergency, err := emergencyInfoServices.Decrypt(info)
+		if err != nil {
+			return nil, err
+		}
+
+		records := make([]map[string]any, 0, len(emergency.Contacts))
+		for _, contact := range emergency.Contacts {
+			records = append(records, map[string]any{
+				"contact_name":         contact.Name,
+				"contact_relationship": contact.Relationship,
+				"contact_phone_number": contact.PhoneNumber,
+				"contact_email":        contact.Email,
+				"allergies":            strings.Join(emergency.Allergies, ";"),
+				"conditions":           strings.Join(emergency.Conditions, ";"),
+				"medications":          strings.Join(emergency.Medications, ";"),
+				"blood_type":           emergency.BloodType,
+				"notes":                emergency.Notes,
+			})
+		}
+		sections = append(sections, section{Name: "emergency_info", Records: records})
+	}
+
+	preference, err := d.repository.GetPreference().FindByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, errConstant.ErrPreferenceNotFound) {
+		return nil, err
+	}
+	if preference != nil {
+		records := make([]map[string]any, 0, len(preference.Values))
+		for key, value := range preference.Values {
+			records = append(records, map[string]any{"key": key, "value": value})
+		}
+		sections = append(sections, section{Name: "preferences", Records: records})
+	}
+
+	attributes, err := d.repository.GetAttribute().FindValues(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(attributes) > 0 {
+		records := make([]map[string]any, 0, len(attributes))
+		for _, attribute := range attributes {
+			records = append(records, map[string]any{
+				"key":   attribute.Definition.Key,
+				"label": attribute.Definition.Label,
+				"value": attribute.Value,
+			})
+		}
+		sections = append(sections, section{Name: "attributes", Records: records})
+	}
+
+	memberships, err := d.repository.GetMembership().FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(memberships) > 0 {
+		records := make([]map[string]any, 0, len(memberships))
+		for _, membership := range memberships {
+			records = append(records, map[string]any{
+				"tier":       membership.Tier,
+				"status":     membership.Status,
+				"starts_at":  membership.StartsAt,
+				"expires_at": valueOf(membership.ExpiresAt),
+				"revoked_at": valueOf(membership.RevokedAt),
+			})
+		}
+		sections = append(sections, section{Name: "memberships", Records: records})
+	}
+
+	acceptances, err := d.repository.GetConsent().FindAcceptances(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(acceptances) > 0 {
+		records := make([]map[string]any, 0, len(acceptances))
+		for _, acceptance := range acceptances {
+			records = append(records, map[string]any{
+				"type":        acceptance.Document.Type,
+				"version":     acceptance.Document.Version,
+				"accepted_at": acceptance.AcceptedAt,
+				"ip_address":  acceptance.IPAddress,
+				"user_agent":  acceptance.UserAgent,
+			})
+		}
+		sections = append(sections, section{Name: "consents", Records: records})
+	}
+
+	suspensions, err := d.repository.GetSuspension().FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(suspensions) > 0 {
+		records := make([]map[string]any, 0, len(suspensions))
+		for _, suspension := range suspensions {
+			records = append(records, map[string]any{
+				"category":   suspension.Category,
+				"reason":     suspension.Reason,
+				"expires_at": valueOf(suspension.ExpiresAt),
+				"lifted_at":  valueOf(suspension.LiftedAt),
+				"created_at": valueOf(suspension.CreatedAt),
+			})
+		}
+		sections = append(sections, section{Name: "suspensions", Records: records})
+	}
+
+	histories, err := d.repository.GetUsername().FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(histories) > 0 {
+		records := make([]map[string]any, 0, len(histories))
+		for _, history := range histories {
+			records = append(records, map[string]any{
+				"username":     history.Username,
+				"new_username": history.NewUsername,
+				"changed_at":   history.CreatedAt,
+			})
+		}
+		sections = append(sections, section{Name: "username_history", Records: records})
+	}
+
+	return sections, nil
+}
+
+func writeSections(archive *zip.Writer, sections []section) error {
+	document := make(map[string][]map[string]any, len(sections))
+	for _, item := range sections {
+		document[item.Name] = item.Records
+
+		writer, err := archive.Create(fmt.Sprintf("%s.csv", item.Name))
+		if err != nil {
+			return err
+		}
+
+		err = writeCSV(writer, item.Records)
+		if err != nil {
+			return err
+		}
+	}
+
+	writer, err := archive.Create("data.json")
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(document)
+}
+
+func writeCSV(writer io.Writer, records []map[string]any) error {
+	columns := make([]string, 0)
+	for _, record := range records {
+		for key := range record {
+			if !slices.Contains(columns, key) {
+				columns = append(columns, key)
+			}
+		}
+	}
+	slices.Sort(columns)
+
+	csvWriter := csv.NewWriter(writer)
+	err := csvWriter.Write(columns)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		row := make([]string, 0, len(columns))
+		for _, column := range columns {
+			value, ok := record[column]
+			if !ok || value == nil {
+				row = append(row, "")
+				continue
+			}
+			row = append(row, fmt.Sprint(value))
+		}
+
+		err = csvWriter.Write(row)
+		if err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+
+	return csvWriter.Error()
+}
+
+func sign(uuid string, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(config.Config.SignatureKey))
+	mac.Write([]byte(fmt.Sprintf("%s:%d", uuid, expires)))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func formatTime(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.Format(time.RFC3339)
+}
+
+func valueOf[T any](value *T) any {
+	if value == nil {
+		return nil
+	}
+
+	return *value
+}
+
+func linkExpirationMinute() int {
+	if config.Config.DataExport.LinkExpirationMinute <= 0 {
+		return defaultLinkExpirationMinute
+	}
+
+	return config.Config.DataExport.LinkExpirationMinute
+}
+
+func retentionHour() int {
+	if config.Config.DataExport.RetentionHour <= 0 {
+		return defaultRetentionHour
+	}
+
+	return config.Config.DataExport.RetentionHour
+}
+
+func staleMinute() int {
+	if config.Config.DataExport.StaleMinute <= 0 {
+		return defaultStaleMinute
+	}
+
+	return config.Config.DataExport.StaleMinute
+}
+
+func toDataExportResponse(export *models.DataExport, downloadURL *string) *dto.DataExportResponse {
+	return &dto.DataExportResponse{
+		UUID:        export.UUID,
+		Status:      export.Status,
+		DownloadURL: downloadURL,
+		ExpiresAt:   export.ExpiresAt,
+		CompletedAt: export.CompletedAt,
+		CreatedAt:   export.CreatedAt,
+	}
+}
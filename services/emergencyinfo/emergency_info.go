@@ -0,0 +1,287 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"user-service/common/encryption"
+	"user-service/common/util"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+
+	"github.com/google/uuid"
+)
+
+const accessLogLimit = 100
+
+type EmergencyInfoService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IEmergencyInfoService interface {
+	Save(context.Context, *dto.EmergencyInfoRequest) (*dto.EmergencyInfoResponse, error)
+	Get(context.Context, string, *dto.RequestMeta) (*dto.EmergencyInfoResponse, error)
+	Delete(context.Context) error
+	Grant(context.Context, *dto.EmergencyAccessGrantRequest) (*dto.EmergencyAccessGrantResponse, error)
+	ListGrants(context.Context) ([]dto.EmergencyAccessGrantResponse, error)
+	Revoke(context.Context, string) error
+	ListAccessLogs(context.Context) ([]dto.EmergencyAccessLogResponse, error)
+}
+
+func NewEmergencyInfoService(repository repositories.IRepositoryRegistry) IEmergencyInfoService {
+	return &EmergencyInfoService{
+		repository: repository,
+	}
+}
+
+func (e *EmergencyInfoService) Save(ctx context.Context, req *dto.EmergencyInfoRequest) (*dto.EmergencyInfoResponse, error) {
+	user, err := e.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := encryption.Encrypt(content)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &models.EmergencyInfo{
+		UserID: user.ID,
+		Data:   data,
+	}
+	err = e.repository.GetEmergencyInfo().Save(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.EmergencyInfoResponse{
+		Contacts:    req.Contacts,
+		Allergies:   req.Allergies,
+		Conditions:  req.Conditions,
+		Medications: req.Medications,
+		BloodType:   req.BloodType,
+		Notes:       req.Notes,
+		UpdatedAt:   info.UpdatedAt,
+	}, nil
+}
+
+// Get returns the emergency info of the user identified by uuid. Only the owner
+// and grantees with an active grant may read it; every attempt is audited.
+func (e *EmergencyInfoService) Get(ctx context.Context, uuid string, meta *dto.RequestMeta) (*dto.EmergencyInfoResponse, error) {
+	actor, err := e.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := actor
+	if uuid != "" && uuid != actor.UUID.String() {
+		owner, err = e.repository.GetUser().FindByUUID(ctx, uuid)
+		if err != nil {
+			return nil, err
+		}
+
+		allowed, err := e.repository.GetEmergencyInfo().HasActiveGrant(ctx, owner.ID, actor.ID, time.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			err = e.audit(ctx, owner, actor, constants.EmergencyAccessActionDenied, meta)
+			if err != nil {
+				return nil, err
+			}
+			return nil, errConstant.ErrForbidden
+		}
+	}
+
+	info, err := e.repository.GetEmergencyInfo().FindByUserID(ctx, owner.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := Decrypt(info)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.audit(ctx, owner, actor, constants.EmergencyAccessActionRead, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (e *EmergencyInfoService) Delete(ctx context.Context) error {
+	user, err := e.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.repository.GetEmergencyInfo().FindByUserID(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	return e.repository.GetEmergencyInfo().DeleteByUserID(ctx, user.ID)
+}
+
+func (e *EmergencyInfoService) Grant(ctx context.Context, req *dto.EmergencyAccessGrantRequest) (*dto.EmergencyAccessGrantResponse, error) {
+	user, err := e.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GranteeUUID == user.UUID.String() {
+		return nil, errConstant.ErrCannotGrantSelf
+	}
+
+	grantee, err := e.repository.GetUser().FindByUUID(ctx, req.GranteeUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := e.repository.GetEmergencyInfo().HasActiveGrant(ctx, user.ID, grantee.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errConstant.ErrAccessGrantExists
+	}
+
+	grant := &models.EmergencyAccessGrant{
+		UUID:      uuid.New(),
+		UserID:    user.ID,
+		GranteeID: grantee.ID,
+		ExpiresAt: req.ExpiresAt,
+		Grantee:   *grantee,
+	}
+	err = e.repository.GetEmergencyInfo().CreateGrant(ctx, grant)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGrantResponse(grant), nil
+}
+
+func (e *EmergencyInfoService) ListGrants(ctx context.Context) ([]dto.EmergencyAccessGrantResponse, error) {
+	user, err := e.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := e.repository.GetEmergencyInfo().FindGrants(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.EmergencyAccessGrantResponse, 0, len(grants))
+	for i := range grants {
+		response = append(response, *toGrantResponse(&grants[i]))
+	}
+
+	return response, nil
+}
+
+func (e *EmergencyInfoService) Revoke(ctx context.Context, uuid string) error {
+	user, err := e.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	grant, err := e.repository.GetEmergencyInfo().FindGrantByUUID(ctx, user.ID, uuid)
+	if err != nil {
+		return err
+	}
+
+	if grant.RevokedAt != nil {
+		return errConstant.ErrAccessGrantNotFound
+	}
+
+	now := time.Now()
+	grant.RevokedAt = &now
+
+	return e.repository.GetEmergencyInfo().RevokeGrant(ctx, grant)
+}
+
+func (e *EmergencyInfoService) ListAccessLogs(ctx context.Context) ([]dto.EmergencyAccessLogResponse, error) {
+	user, err := e.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := e.repository.GetEmergencyInfo().FindAccessLogs(ctx, user.ID, accessLogLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.EmergencyAccessLogResponse, 0, len(logs))
+	for _, log := range logs {
+		response = append(response, dto.EmergencyAccessLogResponse{
+			Actor:     toContactResponse(&log.Actor),
+			Action:    log.Action,
+			IPAddress: log.IPAddress,
+			UserAgent: log.UserAgent,
+			CreatedAt: log.CreatedAt,
+		})
+	}
+
+	return response, nil
+}
+
+func (e *EmergencyInfoService) currentUser(ctx context.Context) (*models.User, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	return e.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+}
+
+func (e *EmergencyInfoService) audit(ctx context.Context, owner, actor *models.User, action string, meta *dto.RequestMeta) error {
+	return e.repository.GetEmergencyInfo().CreateAccessLog(ctx, &models.EmergencyAccessLog{
+		UserID:    owner.ID,
+		ActorID:   actor.ID,
+		Action:    action,
+		IPAddress: meta.IPAddress,
+		UserAgent: util.Truncate(meta.UserAgent, 255),
+	})
+}
+
+func Decrypt(info *models.EmergencyInfo) (*dto.EmergencyInfoResponse, error) {
+	content, err := encryption.Decrypt(info.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	var response dto.EmergencyInfoResponse
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return nil, errConstant.ErrDecryptionFailed
+	}
+	response.UpdatedAt = info.UpdatedAt
+
+	return &response, nil
+}
+
+func toGrantResponse(grant *models.EmergencyAccessGrant) *dto.EmergencyAccessGrantResponse {
+	return &dto.EmergencyAccessGrantResponse{
+		UUID:      grant.UUID,
+		Grantee:   toContactResponse(&grant.Grantee),
+		ExpiresAt: grant.ExpiresAt,
+		RevokedAt: grant.RevokedAt,
+		CreatedAt: grant.CreatedAt,
+	}
+}
+
+func toContactResponse(user *models.User) dto.UserResponse {
+	return dto.UserResponse{
+		UUID:     user.UUID,
+		Name:     user.Name,
+		Username: user.Username,
+	}
+}
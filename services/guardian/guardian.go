@@ -0,0 +1,283 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"user-service/common/policy"
+	"user-service/config"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+	usernameServices "user-service/services/username"
+)
+
+const defaultAgeOfMajority = 18
+
+type GuardianService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IGuardianService interface {
+	ListMinors(context.Context) ([]dto.UserResponse, error)
+	ListApprovals(context.Context) ([]dto.GuardianApprovalResponse, error)
+	Approve(context.Context, string) (*dto.GuardianApprovalResponse, error)
+	Reject(context.Context, string) (*dto.GuardianApprovalResponse, error)
+}
+
+func NewGuardianService(repository repositories.IRepositoryRegistry) IGuardianService {
+	return &GuardianService{
+		repository: repository,
+	}
+}
+
+func (g *GuardianService) ListMinors(ctx context.Context) ([]dto.UserResponse, error) {
+	guardian, err := g.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	minors, err := g.repository.GetGuardian().FindMinors(ctx, guardian.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.UserResponse, 0, len(minors))
+	for i := range minors {
+		response = append(response, *toMinorResponse(&minors[i]))
+	}
+
+	return response, nil
+}
+
+func (g *GuardianService) ListApprovals(ctx context.Context) ([]dto.GuardianApprovalResponse, error) {
+	guardian, err := g.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals, err := g.repository.GetGuardian().FindPendingApprovals(ctx, guardian.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.GuardianApprovalResponse, 0, len(approvals))
+	for i := range approvals {
+		response = append(response, *ToGuardianApprovalResponse(&approvals[i]))
+	}
+
+	return response, nil
+}
+
+func (g *GuardianService) Approve(ctx context.Context, uuid string) (*dto.GuardianApprovalResponse, error) {
+	approval, guardian, err := g.pendingApproval(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	switch approval.Type {
+	case constants.GuardianApprovalTypeRegistration:
+		err = g.repository.GetUser().UpdateStatus(ctx, approval.Minor.UUID.String(), constants.UserStatusActive)
+		if err != nil {
+			return nil, err
+		}
+		approval.Minor.Status = constants.UserStatusActive
+	case constants.GuardianApprovalTypeProfileUpdate:
+		err = g.applyProfileUpdate(ctx, approval)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return g.decide(ctx, approval, guardian, constants.GuardianApprovalStatusApproved)
+}
+
+func (g *GuardianService) Reject(ctx context.Context, uuid string) (*dto.GuardianApprovalResponse, error) {
+	approval, guardian, err := g.pendingApproval(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	if approval.Type == constants.GuardianApprovalTypeRegistration {
+		err = g.repository.GetUser().Delete(ctx, approval.Minor.UUID.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return g.decide(ctx, approval, guardian, constants.GuardianApprovalStatusRejected)
+}
+
+func (g *GuardianService) currentUser(ctx context.Context) (*models.User, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	return g.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+}
+
+func (g *GuardianService) pendingApproval(ctx context.Context, uuid string) (*models.GuardianApproval, *models.User, error) {
+	guardian, err := g.currentUser(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	approval, err := g.repository.GetGuardian().FindApprovalByUUID(ctx, uuid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	isGuardian, err := g.repository.GetGuardian().IsGuardian(ctx, guardian.ID, approval.MinorID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isGuardian {
+		return nil, nil, errConstant.ErrApprovalNotFound
+	}
+
+	if approval.Status != constants.GuardianApprovalStatusPending {
+		return nil, nil, errConstant.ErrApprovalAlreadyDecided
+	}
+
+	return approval, guardian, nil
+}
+
+func (g *GuardianService) applyProfileUpdate(ctx context.Context, approval *models.GuardianApproval) error {
+	var change dto.GuardianProfileChange
+	err := json.Unmarshal([]byte(approval.Payload), &change)
+	if err != nil {
+		return err
+	}
+
+	req := dto.UpdateRequest{
+		Username:    change.Username,
+		Name:        change.Name,
+		Email:       change.Email,
+		PhoneNumber: change.PhoneNumber,
+	}
+
+	minor := &approval.Minor
+	if minor.Username != req.Username {
+		exists, err := g.repository.GetUser().ExistsByUsername(ctx, req.Username)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errConstant.ErrUsernameExists
+		}
+
+		err = usernameServices.CheckRename(ctx, g.repository, minor, req.Username, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if minor.Email != req.Email {
+		exists, err := g.repository.GetUser().ExistsByEmail(ctx, req.Email)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errConstant.ErrEmailExists
+		}
+	}
+
+	_, err = g.repository.GetUser().Update(ctx, &req, minor.UUID.String())
+	if err != nil {
+		return err
+	}
+
+	if minor.Username != req.Username {
+		err = usernameServices.Record(ctx, g.repository, minor, req.Username, minor.UUID.String())
+		if err != nil {
+			return err
+		}
+	}
+
+	minor.Username = req.Username
+	minor.Name = req.Name
+	minor.Email = req.Email
+	minor.PhoneNumber = req.PhoneNumber
+
+	return nil
+}
+
+func (g *GuardianService) decide(ctx context.Context, approval *models.GuardianApproval, guardian *models.User, status string) (*dto.GuardianApprovalResponse, error) {
+	now := time.Now()
+	approval.Status = status
+	approval.DecidedByID = &guardian.ID
+	approval.DecidedAt = &now
+
+	err := g.repository.GetGuardian().UpdateApproval(ctx, approval)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToGuardianApprovalResponse(approval), nil
+}
+
+func IsMinor(dateOfBirth *time.Time) bool {
+	if dateOfBirth == nil {
+		return false
+	}
+
+	ageOfMajority := config.Config.Guardian.AgeOfMajority
+	if ageOfMajority <= 0 {
+		ageOfMajority = defaultAgeOfMajority
+	}
+
+	return time.Now().Before(dateOfBirth.AddDate(ageOfMajority, 0, 0))
+}
+
+// CheckChange refuses changes a minor makes to their own account outside the
+// profile update, which is the change their guardian approves.
+func CheckChange(ctx context.Context, user *models.User) error {
+	actor, _ := policy.SubjectFromContext(ctx)["uuid"].(string)
+	if IsMinor(user.DateOfBirth) && actor == user.UUID.String() {
+		return errConstant.ErrMinorChangeNotAllowed
+	}
+
+	return nil
+}
+
+func FormatDateOfBirth(dateOfBirth *time.Time) *string {
+	if dateOfBirth == nil {
+		return nil
+	}
+
+	value := dateOfBirth.Format(time.DateOnly)
+	return &value
+}
+
+func ToGuardianApprovalResponse(approval *models.GuardianApproval) *dto.GuardianApprovalResponse {
+	response := &dto.GuardianApprovalResponse{
+		UUID:      approval.UUID,
+		Type:      approval.Type,
+		Status:    approval.Status,
+		DecidedAt: approval.DecidedAt,
+		CreatedAt: approval.CreatedAt,
+	}
+
+	if approval.Minor.ID != 0 {
+		response.Minor = toMinorResponse(&approval.Minor)
+	}
+
+	if approval.Payload != "" {
+		_ = json.Unmarshal([]byte(approval.Payload), &response.Changes)
+	}
+
+	return response
+}
+
+func toMinorResponse(user *models.User) *dto.UserResponse {
+	return &dto.UserResponse{
+		UUID:            user.UUID,
+		Name:            user.Name,
+		Username:        user.Username,
+		Email:           user.Email,
+		PhoneNumber:     user.PhoneNumber,
+		PhoneVerifiedAt: user.PhoneVerifiedAt,
+		Status:          user.Status,
+		DateOfBirth:     FormatDateOfBirth(user.DateOfBirth),
+		Minor:           IsMinor(user.DateOfBirth),
+	}
+}
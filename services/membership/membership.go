@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+	"user-service/common/policy"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+
+	"github.com/google/uuid"
+)
+
+type MembershipService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IMembershipService interface {
+	List(context.Context, string) ([]dto.MembershipResponse, error)
+	Grant(context.Context, *dto.MembershipGrantRequest, string) (*dto.MembershipResponse, error)
+	Revoke(context.Context, *dto.MembershipRevokeRequest, string) error
+	ExpireDue(context.Context) (int64, error)
+}
+
+func NewMembershipService(repository repositories.IRepositoryRegistry) IMembershipService {
+	return &MembershipService{
+		repository: repository,
+	}
+}
+
+func (m *MembershipService) List(ctx context.Context, uuid string) ([]dto.MembershipResponse, error) {
+	user, err := m.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships, err := m.repository.GetMembership().FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.MembershipResponse, 0, len(memberships))
+	for i := range memberships {
+		response = append(response, *ToMembershipResponse(&memberships[i]))
+	}
+
+	return response, nil
+}
+
+func (m *MembershipService) Grant(ctx context.Context, req *dto.MembershipGrantRequest, userUUID string) (*dto.MembershipResponse, error) {
+	user, err := m.repository.GetUser().FindByUUID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	startsAt := time.Now()
+	if req.StartsAt != nil {
+		startsAt = *req.StartsAt
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(startsAt) {
+		return nil, errConstant.ErrInvalidMembershipPeriod
+	}
+
+	membership := &models.Membership{
+		UUID:      uuid.New(),
+		UserID:    user.ID,
+		Tier:      req.Tier,
+		Status:    constants.MembershipStatusActive,
+		StartsAt:  startsAt,
+		ExpiresAt: req.ExpiresAt,
+		GrantedBy: actor(ctx),
+	}
+	err = m.repository.GetMembership().Grant(ctx, membership)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToMembershipResponse(membership), nil
+}
+
+func (m *MembershipService) Revoke(ctx context.Context, req *dto.MembershipRevokeRequest, uuid string) error {
+	user, err := m.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return m.repository.GetMembership().Revoke(ctx, user.ID, actor(ctx), req.Reason)
+}
+
+func (m *MembershipService) ExpireDue(ctx context.Context) (int64, error) {
+	return m.repository.GetMembership().ExpireDue(ctx, time.Now())
+}
+
+// Entitlements returns the perks of the membership in effect for userID, or
+// nil when the user has none.
+func Entitlements(ctx context.Context, repository repositories.IRepositoryRegistry, userID uint) ([]string, error) {
+	membership, err := repository.GetMembership().FindCurrent(ctx, userID, time.Now())
+	if err != nil {
+		if errors.Is(err, errConstant.ErrMembershipNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return constants.MembershipEntitlements[membership.Tier], nil
+}
+
+func ToMembershipResponse(membership *models.Membership) *dto.MembershipResponse {
+	return &dto.MembershipResponse{
+		UUID:         membership.UUID,
+		Tier:         membership.Tier,
+		Status:       membership.Status,
+		Entitlements: constants.MembershipEntitlements[membership.Tier],
+		StartsAt:     membership.StartsAt,
+		ExpiresAt:    membership.ExpiresAt,
+		GrantedBy:    membership.GrantedBy,
+		RevokedBy:    membership.RevokedBy,
+		RevokeReason: membership.RevokeReason,
+		RevokedAt:    membership.RevokedAt,
+		CreatedAt:    membership.CreatedAt,
+	}
+}
+
+// actor identifies who changed a membership: the logged in admin, or the
+// calling service on internal routes.
+func actor(ctx context.Context) string {
+	uuid, ok := policy.SubjectFromContext(ctx)["uuid"].(string)
+	if !ok {
+		return constants.MembershipActorService
+	}
+
+	return uuid
+}
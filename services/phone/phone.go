@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+	"user-service/common/otp"
+	"user-service/config"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+	guardianServices "user-service/services/guardian"
+)
+
+const (
+	defaultOtpLength           = 6
+	defaultOtpExpirationMinute = 5
+	defaultOtpResendSecond     = 60
+	defaultOtpMaxAttempt       = 5
+)
+
+type PhoneService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IPhoneService interface {
+	RequestVerification(context.Context) (*dto.PhoneVerificationResponse, error)
+	ConfirmVerification(context.Context, *dto.PhoneVerificationConfirmRequest) (*dto.PhoneVerifiedResponse, error)
+}
+
+func NewPhoneService(repository repositories.IRepositoryRegistry) IPhoneService {
+	return &PhoneService{
+		repository: repository,
+	}
+}
+
+// RequestVerification sends a new code to the user's current phone number,
+// replacing any code sent before.
+func (p *PhoneService) RequestVerification(ctx context.Context) (*dto.PhoneVerificationResponse, error) {
+	user, err := p.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = guardianServices.CheckChange(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if user.PhoneNumber == "" {
+		return nil, errConstant.ErrPhoneNumberNotSet
+	}
+	if user.PhoneVerifiedAt != nil {
+		return nil, errConstant.ErrPhoneAlreadyVerified
+	}
+
+	now := time.Now()
+	previous, err := p.repository.GetPhone().FindByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, errConstant.ErrPhoneVerificationNotFound) {
+		return nil, err
+	}
+	// The cooldown applies per user, so switching numbers does not reset it.
+	if previous != nil && resendAt(previous).After(now) {
+		return nil, errConstant.ErrOtpResendTooSoon
+	}
+
+	code, err := otp.GenerateCode(otpLength())
+	if err != nil {
+		return nil, err
+	}
+
+	verification := &models.PhoneVerification{
+		UserID:      user.ID,
+		PhoneNumber: user.PhoneNumber,
+		CodeHash:    hashCode(user, code),
+		ExpiresAt:   now.Add(time.Duration(otpExpirationMinute()) * time.Minute),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	err = p.repository.GetPhone().Save(ctx, verification)
+	if err != nil {
+		return nil, err
+	}
+
+	err = otp.Default().Send(ctx, user.PhoneNumber, code)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PhoneVerificationResponse{
+		PhoneNumber: user.PhoneNumber,
+		ExpiresAt:   verification.ExpiresAt,
+		ResendAt:    resendAt(verification),
+	}, nil
+}
+
+func (p *PhoneService) ConfirmVerification(ctx context.Context, req *dto.PhoneVerificationConfirmRequest) (*dto.PhoneVerifiedResponse, error) {
+	user, err := p.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = guardianServices.CheckChange(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := p.repository.GetPhone().FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if verification.PhoneNumber != user.PhoneNumber {
+		return nil, errConstant.ErrPhoneVerificationNotFound
+	}
+
+	now := time.Now()
+	if !verification.ExpiresAt.After(now) {
+		return nil, errConstant.ErrOtpExpired
+	}
+	err = p.repository.GetPhone().ConsumeAttempt(ctx, verification, otpMaxAttempt())
+	if err != nil {
+		return nil, err
+	}
+
+	if !hmac.Equal([]byte(verification.CodeHash), []byte(hashCode(user, req.Code))) {
+		return nil, errConstant.ErrOtpInvalid
+	}
+
+	err = p.repository.GetPhone().MarkVerified(ctx, verification, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PhoneVerifiedResponse{
+		PhoneNumber:     user.PhoneNumber,
+		PhoneVerifiedAt: now,
+	}, nil
+}
+
+func (p *PhoneService) currentUser(ctx context.Context) (*models.User, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	return p.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+}
+
+// hashCode binds the code to the user and number it was sent to, so a stored
+// hash is useless for any other account.
+func hashCode(user *models.User, code string) string {
+	mac := hmac.New(sha256.New, []byte(config.Config.SignatureKey))
+	mac.Write([]byte(user.UUID.String() + ":" + user.PhoneNumber + ":" + code))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func resendAt(verification *models.PhoneVerification) time.Time {
+	return verification.CreatedAt.Add(time.Duration(otpResendSecond()) * time.Second)
+}
+
+func otpLength() int {
+	length := config.Config.Phone.OtpLength
+	if length <= 0 {
+		length = defaultOtpLength
+	}
+
+	return length
+}
+
+func otpExpirationMinute() int {
+	expiration := config.Config.Phone.OtpExpirationMinute
+	if expiration <= 0 {
+		expiration = defaultOtpExpirationMinute
+	}
+
+	return expiration
+}
+
+func otpResendSecond() int {
+	resend := config.Config.Phone.OtpResendSecond
+	if resend <= 0 {
+		resend = defaultOtpResendSecond
+	}
+
+	return resend
+}
+
+func otpMaxAttempt() int {
+	maxAttempt := config.Config.Phone.OtpMaxAttempt
+	if maxAttempt <= 0 {
+		maxAttempt = defaultOtpMaxAttempt
+	}
+
+	return maxAttempt
+}
@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+	"unicode/utf8"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+)
+
+var Schema = []dto.PreferenceDefinition{
+	{
+		Key:         "language",
+		Type:        constants.PreferenceTypeString,
+		Default:     "id",
+		Enum:        []string{"id", "en"},
+		Description: "interface language",
+	},
+	{
+		Key:         "timezone",
+		Type:        constants.PreferenceTypeString,
+		Default:     "Asia/Jakarta",
+		Format:      constants.PreferenceFormatTimezone,
+		MaxLength:   intPointer(64),
+		Description: "IANA time zone used to display match times",
+	},
+	{
+		Key:         "units",
+		Type:        constants.PreferenceTypeString,
+		Default:     "metric",
+		Enum:        []string{"metric", "imperial"},
+		Description: "measurement units",
+	},
+	{
+		Key:         "preferred_sports",
+		Type:        constants.PreferenceTypeStringList,
+		Default:     []string{},
+		Max:         intPointer(20),
+		MaxLength:   intPointer(50),
+		Description: "sports shown first in listings",
+	},
+	{
+		Key:         "nearby_radius_km",
+		Type:        constants.PreferenceTypeInteger,
+		Default:     10,
+		Min:         intPointer(1),
+		Max:         intPointer(100),
+		Description: "radius used for nearby match suggestions",
+	},
+	{
+		Key:         "notify_nearby_matches",
+		Type:        constants.PreferenceTypeBoolean,
+		Default:     true,
+		Description: "notify me of matches nearby",
+	},
+	{
+		Key:         "notify_match_reminders",
+		Type:        constants.PreferenceTypeBoolean,
+		Default:     true,
+		Description: "remind me before matches I joined",
+	},
+	{
+		Key:         "notify_marketing",
+		Type:        constants.PreferenceTypeBoolean,
+		Default:     false,
+		Description: "news and promotions",
+	},
+}
+
+type PreferenceService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IPreferenceService interface {
+	GetSchema() []dto.PreferenceDefinition
+	Get(context.Context) (*dto.PreferenceResponse, error)
+	GetByUUID(context.Context, string) (*dto.PreferenceResponse, error)
+	Update(context.Context, map[string]any) (*dto.PreferenceResponse, error)
+}
+
+func NewPreferenceService(repository repositories.IRepositoryRegistry) IPreferenceService {
+	return &PreferenceService{
+		repository: repository,
+	}
+}
+
+func (p *PreferenceService) GetSchema() []dto.PreferenceDefinition {
+	return Schema
+}
+
+func (p *PreferenceService) Get(ctx context.Context) (*dto.PreferenceResponse, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	return p.GetByUUID(ctx, userLogin.UUID.String())
+}
+
+func (p *PreferenceService) GetByUUID(ctx context.Context, uuid string) (*dto.PreferenceResponse, error) {
+	user, err := p.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	preference, err := p.find(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPreferenceResponse(preference), nil
+}
+
+// Update merges changes into the stored overrides. A null value resets the key
+// to its default.
+func (p *PreferenceService) Update(ctx context.Context, changes map[string]any) (*dto.PreferenceResponse, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	user, err := p.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	preference, err := p.find(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range changes {
+		definition, ok := findDefinition(key)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", errConstant.ErrUnknownPreference, key)
+		}
+
+		if value == nil {
+			delete(preference.Values, key)
+			continue
+		}
+
+		normalized, err := validate(definition, value)
+		if err != nil {
+			return nil, err
+		}
+		preference.Values[key] = normalized
+	}
+
+	err = p.repository.GetPreference().Save(ctx, preference)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPreferenceResponse(preference), nil
+}
+
+func (p *PreferenceService) find(ctx context.Context, userID uint) (*models.UserPreference, error) {
+	preference, err := p.repository.GetPreference().FindByUserID(ctx, userID)
+	if errors.Is(err, errConstant.ErrPreferenceNotFound) {
+		return &models.UserPreference{UserID: userID, Values: map[string]any{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if preference.Values == nil {
+		preference.Values = map[string]any{}
+	}
+
+	return preference, nil
+}
+
+func validate(definition dto.PreferenceDefinition, value any) (any, error) {
+	invalid := func(reason string) error {
+		return fmt.Errorf("%w: %s %s", errConstant.ErrInvalidPreference, definition.Key, reason)
+	}
+
+	switch definition.Type {
+	case constants.PreferenceTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return nil, invalid("must be a boolean")
+		}
+	case constants.PreferenceTypeInteger:
+		number, ok := value.(float64)
+		if !ok || number != math.Trunc(number) {
+			return nil, invalid("must be an integer")
+		}
+		if definition.Min != nil && number < float64(*definition.Min) {
+			return nil, invalid(fmt.Sprintf("must be at least %d", *definition.Min))
+		}
+		if definition.Max != nil && number > float64(*definition.Max) {
+			return nil, invalid(fmt.Sprintf("must be at most %d", *definition.Max))
+		}
+		return int(number), nil
+	case constants.PreferenceTypeString:
+		text, ok := value.(string)
+		if !ok {
+			return nil, invalid("must be a string")
+		}
+		if len(definition.Enum) > 0 && !slices.Contains(definition.Enum, text) {
+			return nil, invalid(fmt.Sprintf("must be one of %v", definition.Enum))
+		}
+		if definition.MaxLength != nil && utf8.RuneCountInString(text) > *definition.MaxLength {
+			return nil, invalid(fmt.Sprintf("must be at most %d characters", *definition.MaxLength))
+		}
+		if definition.Format == constants.PreferenceFormatTimezone {
+			if _, err := time.LoadLocation(text); err != nil || text == "" || text == "Local" {
+				return nil, invalid("must be an IANA time zone")
+			}
+		}
+	case constants.PreferenceTypeStringList:
+		items, ok := value.([]any)
+		if !ok {
+			return nil, invalid("must be a list of strings")
+		}
+		if definition.Max != nil && len(items) > *definition.Max {
+			return nil, invalid(fmt.Sprintf("must have at most %d items", *definition.Max))
+		}
+		list := make([]string, 0, len(items))
+		for _, item := range items {
+			text, ok := item.(string)
+			if !ok {
+				return nil, invalid("must be a list of strings")
+			}
+			if len(definition.Enum) > 0 && !slices.Contains(definition.Enum, text) {
+				return nil, invalid(fmt.Sprintf("items must be one of %v", definition.Enum))
+			}
+			if definition.MaxLength != nil && utf8.RuneCountInString(text) > *definition.MaxLength {
+				return nil, invalid(fmt.Sprintf("items must be at most %d characters", *definition.MaxLength))
+			}
+			list = append(list, text)
+		}
+		return list, nil
+	}
+
+	return value, nil
+}
+
+func findDefinition(key string) (dto.PreferenceDefinition, bool) {
+	for _, definition := range Schema {
+		if definition.Key == key {
+			return definition, true
+		}
+	}
+
+	return dto.PreferenceDefinition{}, false
+}
+
+func toPreferenceResponse(preference *models.UserPreference) *dto.PreferenceResponse {
+	values := make(map[string]any, len(Schema))
+	for _, definition := range Schema {
+		values[definition.Key] = definition.Default
+	}
+
+	for key, value := range preference.Values {
+		if _, ok := findDefinition(key); ok {
+			values[key] = value
+		}
+	}
+
+	return &dto.PreferenceResponse{
+		Values:    values,
+		UpdatedAt: preference.UpdatedAt,
+	}
+}
+
+func intPointer(value int) *int {
+	return &value
+}
@@ -2,7 +2,22 @@ package services
 
 import (
 	"user-service/repositories"
-	services "user-service/services/user"
+	athleteProfileServices "user-service/services/athleteprofile"
+	attributeServices "user-service/services/attribute"
+	authzServices "user-service/services/authz"
+	avatarServices "user-service/services/avatar"
+	consentServices "user-service/services/consent"
+	dataExportServices "user-service/services/dataexport"
+	emergencyInfoServices "user-service/services/emergencyinfo"
+	guardianServices "user-service/services/guardian"
+	membershipServices "user-service/services/membership"
+	phoneServices "user-service/services/phone"
+	preferenceServices "user-service/services/preference"
+	suspensionServices "user-service/services/suspension"
+	userServices "user-service/services/user"
+	userExportServices "user-service/services/userexport"
+	userImportServices "user-service/services/userimport"
+	usernameServices "user-service/services/username"
 )
 
 type Registry struct {
@@ -10,7 +25,22 @@ type Registry struct {
 }
 
 type IServiceRegistry interface {
-	GetUser() services.IUserService
+	GetUser() userServices.IUserService
+	GetAuthz() authzServices.IAuthzService
+	GetDataExport() dataExportServices.IDataExportService
+	GetUserImport() userImportServices.IUserImportService
+	GetUserExport() userExportServices.IUserExportService
+	GetAvatar() avatarServices.IAvatarService
+	GetAthleteProfile() athleteProfileServices.IAthleteProfileService
+	GetGuardian() guardianServices.IGuardianService
+	GetEmergencyInfo() emergencyInfoServices.IEmergencyInfoService
+	GetPreference() preferenceServices.IPreferenceService
+	GetAttribute() attributeServices.IAttributeService
+	GetMembership() membershipServices.IMembershipService
+	GetConsent() consentServices.IConsentService
+	GetSuspension() suspensionServices.ISuspensionService
+	GetUsername() usernameServices.IUsernameService
+	GetPhone() phoneServices.IPhoneService
 }
 
 func NewServiceRegistry(repository repositories.IRepositoryRegistry) IServiceRegistry {
@@ -19,6 +49,66 @@ func NewServiceRegistry(repository repositories.IRepositoryRegistry) IServiceReg
 	}
 }
 
-func (r *Registry) GetUser() services.IUserService {
-	return services.NewUserService(r.repository)
+func (r *Registry) GetUser() userServices.IUserService {
+	return userServices.NewUserService(r.repository)
+}
+
+func (r *Registry) GetAuthz() authzServices.IAuthzService {
+	return authzServices.NewAuthzService(r.repository)
+}
+
+func (r *Registry) GetDataExport() dataExportServices.IDataExportService {
+	return dataExportServices.NewDataExportService(r.repository)
+}
+
+func (r *Registry) GetUserImport() userImportServices.IUserImportService {
+	return userImportServices.NewUserImportService(r.repository)
+}
+
+func (r *Registry) GetUserExport() userExportServices.IUserExportService {
+	return userExportServices.NewUserExportService(r.repository)
+}
+
+func (r *Registry) GetAvatar() avatarServices.IAvatarService {
+	return avatarServices.NewAvatarService(r.repository)
+}
+
+func (r *Registry) GetAthleteProfile() athleteProfileServices.IAthleteProfileService {
+	return athleteProfileServices.NewAthleteProfileService(r.repository)
+}
+
+func (r *Registry) GetGuardian() guardianServices.IGuardianService {
+	return guardianServices.NewGuardianService(r.repository)
+}
+
+func (r *Registry) GetEmergencyInfo() emergencyInfoServices.IEmergencyInfoService {
+	return emergencyInfoServices.NewEmergencyInfoService(r.repository)
+}
+
+func (r *Registry) GetPreference() preferenceServices.IPreferenceService {
+	return preferenceServices.NewPreferenceService(r.repository)
+}
+
+func (r *Registry) GetAttribute() attributeServices.IAttributeService {
+	return attributeServices.NewAttributeService(r.repository)
+}
+
+func (r *Registry) GetMembership() membershipServices.IMembershipService {
+	return membershipServices.NewMembershipService(r.repository)
+}
+
+func (r *Registry) GetConsent() consentServices.IConsentService {
+	return consentServices.NewConsentService(r.repository)
+}
+
+func (r *Registry) GetSuspension() suspensionServices.ISuspensionService {
+	return suspensionServices.NewSuspensionService(r.repository)
+}
+
+func (r *Registry) GetUsername() usernameServices.IUsernameService {
+	return usernameServices.NewUsernameService(r.repository)
+}
+
+func (r *Registry) GetPhone() phoneServices.IPhoneService {
+	return phoneServices.NewPhoneService(r.repository)
 }
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/common/policy"
+	"user-service/config"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+
+	"github.com/google/uuid"
+)
+
+const defaultLiftBatchSize = 100
+
+type SuspensionService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type ISuspensionService interface {
+	List(context.Context, string) ([]dto.SuspensionResponse, error)
+	Suspend(context.Context, *dto.SuspensionRequest, string) (*dto.SuspensionResponse, error)
+	Lift(context.Context, *dto.SuspensionLiftRequest, string) (*dto.SuspensionResponse, error)
+	LiftExpired(context.Context) (int, error)
+}
+
+func NewSuspensionService(repository repositories.IRepositoryRegistry) ISuspensionService {
+	return &SuspensionService{
+		repository: repository,
+	}
+}
+
+func (s *SuspensionService) List(ctx context.Context, uuid string) ([]dto.SuspensionResponse, error) {
+	user, err := s.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	suspensions, err := s.repository.GetSuspension().FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.SuspensionResponse, 0, len(suspensions))
+	for i := range suspensions {
+		response = append(response, *toSuspensionResponse(&suspensions[i]))
+	}
+
+	return response, nil
+}
+
+// Suspend bans an active user until ExpiresAt, or permanently when it is
+// not set.
+func (s *SuspensionService) Suspend(ctx context.Context, req *dto.SuspensionRequest, userUUID string) (*dto.SuspensionResponse, error) {
+	actor, _ := policy.SubjectFromContext(ctx)["uuid"].(string)
+	if actor == userUUID {
+		return nil, errConstant.ErrForbidden
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, errConstant.ErrInvalidSuspensionExpiry
+	}
+
+	user, err := s.repository.GetUser().FindByUUID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	switch user.Status {
+	case constants.UserStatusActive:
+	case constants.UserStatusSuspended:
+		return nil, errConstant.ErrUserAlreadySuspended
+	default:
+		return nil, errConstant.ErrUserNotActive
+	}
+
+	suspension := &models.Suspension{
+		UUID:      uuid.New(),
+		UserID:    user.ID,
+		Category:  req.Category,
+		Reason:    req.Reason,
+		ImposedBy: actor,
+		ExpiresAt: req.ExpiresAt,
+	}
+	err = s.repository.GetSuspension().Create(ctx, suspension)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSuspensionResponse(suspension), nil
+}
+
+func (s *SuspensionService) Lift(ctx context.Context, req *dto.SuspensionLiftRequest, uuid string) (*dto.SuspensionResponse, error) {
+	user, err := s.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	suspension, err := s.repository.GetSuspension().FindOpen(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, _ := policy.SubjectFromContext(ctx)["uuid"].(string)
+	err = lift(ctx, s.repository, suspension, actor, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSuspensionResponse(suspension), nil
+}
+
+func (s *SuspensionService) LiftExpired(ctx context.Context) (int, error) {
+	batchSize := config.Config.Suspension.JobBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultLiftBatchSize
+	}
+
+	suspensions, err := s.repository.GetSuspension().FindExpired(ctx, time.Now(), batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range suspensions {
+		err = lift(ctx, s.repository, &suspensions[i], constants.SuspensionActorSystem, constants.SuspensionReasonExpired)
+		if err != nil {
+			return i, err
+		}
+	}
+
+	return len(suspensions), nil
+}
+
+// Check explains why a suspended user is rejected. A suspension that has
+// already expired is lifted on the spot and nil is returned.
+func Check(ctx context.Context, repository repositories.IRepositoryRegistry, user *models.User) error {
+	suspension, err := repository.GetSuspension().FindOpen(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, errConstant.ErrSuspensionNotFound) {
+			return errConstant.ErrUserSuspended
+		}
+
+		return err
+	}
+
+	if suspension.ExpiresAt == nil {
+		return fmt.Errorf("%w permanently: %s", errConstant.ErrUserSuspended, suspension.Reason)
+	}
+	if suspension.ExpiresAt.After(time.Now()) {
+		return fmt.Errorf("%w until %s: %s", errConstant.ErrUserSuspended, suspension.ExpiresAt.Format(time.RFC3339), suspension.Reason)
+	}
+
+	err = lift(ctx, repository, suspension, constants.SuspensionActorSystem, constants.SuspensionReasonExpired)
+	if err != nil {
+		return err
+	}
+	user.Status = constants.UserStatusActive
+
+	return nil
+}
+
+func lift(ctx context.Context, repository repositories.IRepositoryRegistry, suspension *models.Suspension, actor, reason string) error {
+	now := time.Now()
+	suspension.LiftedBy = actor
+	suspension.LiftReason = reason
+	suspension.LiftedAt = &now
+
+	return repository.GetSuspension().Lift(ctx, suspension)
+}
+
+func toSuspensionResponse(suspension *models.Suspension) *dto.SuspensionResponse {
+	return &dto.SuspensionResponse{
+		UUID:       suspension.UUID,
+		Category:   suspension.Category,
+		Reason:     suspension.Reason,
+		ImposedBy:  suspension.ImposedBy,
+		Permanent:  suspension.ExpiresAt == nil,
+		ExpiresAt:  suspension.ExpiresAt,
+		LiftedBy:   suspension.LiftedBy,
+		LiftReason: suspension.LiftReason,
+		LiftedAt:   suspension.LiftedAt,
+		CreatedAt:  suspension.CreatedAt,
+	}
+}
@@ -2,16 +2,48 @@ package services
 
 import (
 	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"os"
+	"slices"
 	"strings"
 	"time"
+	"user-service/common/breach"
+	"user-service/common/pagination"
+	"user-service/common/password"
+	"user-service/common/policy"
 	"user-service/config"
 	"user-service/constants"
 	errorConstant "user-service/constants/error"
 	"user-service/domain/dto"
+	"user-service/domain/models"
 	"user-service/repositories"
+	userRepositories "user-service/repositories/user"
+	athleteProfileServices "user-service/services/athleteprofile"
+	attributeServices "user-service/services/attribute"
+	avatarServices "user-service/services/avatar"
+	consentServices "user-service/services/consent"
+	guardianServices "user-service/services/guardian"
+	membershipServices "user-service/services/membership"
+	suspensionServices "user-service/services/suspension"
+	usernameServices "user-service/services/username"
 
 	"github.com/golang-jwt/jwt/v5"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultListLimit    = 20
+	defaultMaxBatchSize = 100
+
+	defaultDeletionGracePeriodDay = 30
+	defaultDeletionBatchSize      = 100
+	highlightOpen                 = "<mark>"
+	highlightClose                = "</mark>"
 )
 
 type UserService struct {
@@ -24,14 +56,41 @@ type IUserService interface {
 	Update(context.Context, *dto.UpdateRequest, string) (*dto.UserResponse, error)
 	UpdatePassword(context.Context, *dto.UpdatePasswordRequest, string) (*dto.UserResponse, error)
 	GetUserLogin(context.Context) (*dto.UserResponse, error)
-	GetUserByUUID(context.Context, string) (*dto.UserResponse, error)
+	GetUserByUUID(context.Context, *dto.UserDetailRequest, string) (*dto.UserResponse, error)
+	GetUsersByUUIDs(context.Context, *dto.UserBatchRequest) (*dto.UserBatchResponse, error)
+	ListUsers(context.Context, *dto.UserFilterRequest) (*dto.UserListResponse, error)
+	SearchUsers(context.Context, *dto.UserSearchRequest) (*dto.UserSearchResponse, error)
+	CheckAccount(context.Context, string) error
+	ResourceAttributes(context.Context, string, string) (policy.Attributes, error)
+	DeleteUser(context.Context, string) error
+	RestoreUser(context.Context, string) (*dto.UserResponse, error)
+	DeactivateUser(context.Context, string) (*dto.UserResponse, error)
+	ReactivateUser(context.Context, string) (*dto.UserResponse, error)
+	DeleteAccount(context.Context, *dto.DeleteAccountRequest) (*dto.DeleteAccountResponse, error)
+	AnonymizeDueAccounts(context.Context) (int, error)
 }
 
 type Claims struct {
-	User *dto.UserResponse
+	User     *dto.UserResponse
+	Scope    string `json:"scope,omitempty"`
+	ClientID string `json:"client_id,omitempty"`
+	Minor    bool   `json:"minor"`
+	// Entitlements lists the perks of the membership in effect at login so
+	// other services can enforce them without calling back.
+	Entitlements []string `json:"entitlements,omitempty"`
 	jwt.RegisteredClaims
 }
 
+func (c *Claims) HasScope(scope string) bool {
+	for _, item := range strings.Fields(c.Scope) {
+		if item == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
 func NewUserService(repository repositories.IRepositoryRegistry) IUserService {
 	return &UserService{
 		repository: repository,
@@ -44,23 +103,99 @@ func (u *UserService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.Lo
 		return nil, err
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password))
+	matched, err := password.Verify(user.Password, req.Password)
 	if err != nil {
 		return nil, err
 	}
+	if !matched {
+		return nil, errorConstant.ErrInvalidPassword
+	}
+
+	// Logging in cancels a pending deletion, but only for an account that may
+	// log in otherwise.
+	err = u.accountError(ctx, user)
+	if errors.Is(err, errorConstant.ErrUserPendingDeletion) {
+		err = u.repository.GetUser().CancelDeletion(ctx, user.UUID.String())
+		if err != nil {
+			return nil, err
+		}
+		user.Status = constants.UserStatusActive
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.MustChangePassword && config.Config.BreachedPassword.CheckOnLogin {
+		breached, err := breach.Default().Breached(ctx, req.Password)
+		if err != nil {
+			logrus.Warnf("breached password check failed: %v", err)
+		}
+		if breached {
+			err = u.repository.GetUser().RequirePasswordReset(ctx, user.UUID.String())
+			if err != nil {
+				return nil, err
+			}
+			user.MustChangePassword = true
+		}
+	}
+
+	if password.NeedsRehash(user.Password) {
+		hashedPassword, err := password.Hash(req.Password)
+		if err == nil {
+			err = u.repository.GetUser().UpdatePasswordHash(ctx, user.UUID.String(), hashedPassword)
+		}
+		if err != nil {
+			logrus.Warnf("failed to rehash password of user %s: %v", user.UUID, err)
+		}
+	}
 
 	expirationTime := time.Now().Add(time.Duration(config.Config.JwtExpirationTime) * time.Minute).Unix()
 	data := &dto.UserResponse{
-		UUID:        user.UUID,
-		Name:        user.Name,
-		Username:    user.Username,
-		Email:       user.Email,
-		PhoneNumber: user.PhoneNumber,
-		Role:        strings.ToLower(user.Role.Code),
+		UUID:            user.UUID,
+		Name:            user.Name,
+		Username:        user.Username,
+		Email:           user.Email,
+		PhoneNumber:     user.PhoneNumber,
+		PhoneVerifiedAt: user.PhoneVerifiedAt,
+		Role:            strings.ToLower(user.Role.Code),
+		Status:          user.Status,
+		DateOfBirth:     guardianServices.FormatDateOfBirth(user.DateOfBirth),
+		Minor:           guardianServices.IsMinor(user.DateOfBirth),
+	}
+
+	scopes, err := u.scopes(req.ClientID, req.ClientSecret, data.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	entitlements, err := membershipServices.Entitlements(ctx, u.repository, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingConsents, err := consentServices.Pending(ctx, u.repository, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	// Pending consents and a forced password change limit the token to the
+	// scopes needed to resolve them.
+	if len(pendingConsents) > 0 || user.MustChangePassword {
+		scopes = make([]string, 0, 2)
+		if len(pendingConsents) > 0 {
+			scopes = append(scopes, constants.ScopeConsent)
+		}
+		if user.MustChangePassword {
+			scopes = append(scopes, constants.ScopePasswordChange)
+		}
+		entitlements = nil
 	}
 
 	claims := &Claims{
-		User: data,
+		User:         data,
+		Scope:        strings.Join(scopes, " "),
+		ClientID:     req.ClientID,
+		Minor:        data.Minor,
+		Entitlements: entitlements,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Unix(expirationTime, 0)),
 		},
@@ -73,16 +208,54 @@ func (u *UserService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.Lo
 	}
 
 	response := &dto.LoginResponse{
-		User:  *data,
-		Token: tokenString,
+		User:                  *data,
+		Token:                 tokenString,
+		ConsentRequired:       len(pendingConsents) > 0,
+		PendingConsents:       pendingConsents,
+		PasswordResetRequired: user.MustChangePassword,
 	}
 
 	return response, nil
 }
 
+// scopes narrows the role's scopes to those granted to the client. Without a
+// client the role keeps its scopes within the default set, and a client has to
+// present its secret before its narrower scopes apply.
+func (u *UserService) scopes(clientID, clientSecret, role string) ([]string, error) {
+	allowed := config.Config.DefaultScopes
+	if allowed == nil {
+		allowed = constants.DefaultScopes
+	}
+
+	if clientID != "" {
+		index := slices.IndexFunc(config.Config.Clients, func(client config.Client) bool {
+			return client.ID == clientID
+		})
+		if index < 0 {
+			return nil, errorConstant.ErrInvalidClient
+		}
+
+		client := config.Config.Clients[index]
+		if client.Secret == "" || subtle.ConstantTimeCompare([]byte(client.Secret), []byte(clientSecret)) != 1 {
+			return nil, errorConstant.ErrInvalidClient
+		}
+		allowed = client.Scopes
+	}
+
+	roleScopes := constants.RoleScopes[role]
+	scopes := make([]string, 0, len(roleScopes))
+	for _, scope := range roleScopes {
+		if slices.Contains(allowed, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return scopes, nil
+}
+
 func (u *UserService) IsUsernameExists(ctx context.Context, username string) bool {
-	user, err := u.repository.GetUser().FindByUsername(ctx, username)
-	if user != nil || err != nil {
+	exists, err := u.repository.GetUser().ExistsByUsername(ctx, username)
+	if exists || err != nil {
 		return true
 	}
 
@@ -90,8 +263,8 @@ func (u *UserService) IsUsernameExists(ctx context.Context, username string) boo
 }
 
 func (u *UserService) IsEmailExists(ctx context.Context, email string) bool {
-	user, err := u.repository.GetUser().FindByEmail(ctx, email)
-	if user != nil || err != nil {
+	exists, err := u.repository.GetUser().ExistsByEmail(ctx, email)
+	if exists || err != nil {
 		return true
 	}
 
@@ -102,7 +275,10 @@ func (u *UserService) Register(ctx context.Context, req *dto.RegiterRequest) (*d
 	if req.Password != req.ConfirmPassword {
 		return nil, errorConstant.ErrPasswordIsNotMatch
 	}
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if fieldErrors := password.Validate(ctx, "Password", req.Password, req.Username, req.Email); fieldErrors != nil {
+		return nil, fieldErrors
+	}
+	hashedPassword, err := password.Hash(req.Password)
 	if err != nil {
 		return nil, err
 	}
@@ -111,63 +287,140 @@ func (u *UserService) Register(ctx context.Context, req *dto.RegiterRequest) (*d
 		return nil, errorConstant.ErrUsernameExists
 	}
 
+	err = usernameServices.CheckAvailable(ctx, u.repository, req.Username)
+	if err != nil {
+		return nil, err
+	}
+
 	if u.IsEmailExists(ctx, req.Email) {
 		return nil, errorConstant.ErrEmailExists
 	}
 
+	var guardian *models.User
+	if req.DateOfBirth != "" {
+		dateOfBirth, err := time.ParseInLocation(time.DateOnly, req.DateOfBirth, time.Local)
+		if err != nil {
+			return nil, err
+		}
+
+		if guardianServices.IsMinor(&dateOfBirth) {
+			guardian, err = u.findGuardian(ctx, req.GuardianEmail)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	status := constants.UserStatusActive
+	if guardian != nil {
+		status = constants.UserStatusPendingApproval
+	}
+
 	user, err := u.repository.GetUser().Register(ctx, &dto.RegiterRequest{
 		Username:    req.Username,
 		Name:        req.Name,
-		Password:    string(hashedPassword),
+		Password:    hashedPassword,
 		PhoneNumber: req.PhoneNumber,
 		Email:       req.Email,
+		DateOfBirth: req.DateOfBirth,
 		RoleID:      constants.Customer,
+		Status:      status,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	if guardian != nil {
+		err = u.repository.GetGuardian().Link(ctx, guardian.ID, user.ID, &models.GuardianApproval{
+			UUID:    uuid.New(),
+			MinorID: user.ID,
+			Type:    constants.GuardianApprovalTypeRegistration,
+			Status:  constants.GuardianApprovalStatusPending,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	response := &dto.RegiterResponse{
 		User: dto.UserResponse{
-			UUID:        user.UUID,
-			Name:        user.Name,
-			Username:    user.Username,
-			Email:       user.Email,
-			PhoneNumber: user.PhoneNumber,
+			UUID:            user.UUID,
+			Name:            user.Name,
+			Username:        user.Username,
+			Email:           user.Email,
+			PhoneNumber:     user.PhoneNumber,
+			PhoneVerifiedAt: user.PhoneVerifiedAt,
+			DateOfBirth:     guardianServices.FormatDateOfBirth(user.DateOfBirth),
+			Minor:           guardian != nil,
 		},
+		PendingApproval: guardian != nil,
 	}
 
 	return response, nil
 }
 
+func (u *UserService) findGuardian(ctx context.Context, email string) (*models.User, error) {
+	if email == "" {
+		return nil, errorConstant.ErrGuardianRequired
+	}
+
+	guardian, err := u.repository.GetUser().FindByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, errorConstant.ErrNotFound) {
+			return nil, errorConstant.ErrGuardianNotFound
+		}
+		return nil, err
+	}
+
+	if accountStatusError(guardian) != nil {
+		return nil, errorConstant.ErrGuardianNotFound
+	}
+
+	if guardianServices.IsMinor(guardian.DateOfBirth) {
+		return nil, errorConstant.ErrGuardianIsMinor
+	}
+
+	return guardian, nil
+}
+
 func (u *UserService) Update(ctx context.Context, req *dto.UpdateRequest, uuid string) (*dto.UserResponse, error) {
-	user, err := u.repository.GetUser().FindByUUID(ctx, uuid)
+	err := policy.Authorize(ctx, constants.ActionUserUpdate, policy.Attributes{
+		"type": constants.ResourceUser,
+		"uuid": uuid,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	usernameExists := u.IsUsernameExists(ctx, req.Username)
-	if usernameExists && user.Username != req.Username {
-		checkUsername, err := u.repository.GetUser().FindByUsername(ctx, req.Username)
-		if err != nil {
-			return nil, err
-		}
+	user, err := u.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
 
-		if checkUsername != nil {
+	actor, _ := policy.SubjectFromContext(ctx)["uuid"].(string)
+	renamed := user.Username != req.Username
+	if renamed {
+		if u.IsUsernameExists(ctx, req.Username) {
 			return nil, errorConstant.ErrUsernameExists
 		}
-	}
 
-	emailExists := u.IsEmailExists(ctx, req.Email)
-	if emailExists && user.Email != req.Email {
-		checkEmail, err := u.repository.GetUser().FindByEmail(ctx, req.Email)
+		err = usernameServices.CheckRename(ctx, u.repository, user, req.Username, actor == user.UUID.String())
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		if checkEmail != nil {
-			return nil, errorConstant.ErrEmailExists
-		}
+	if user.Email != req.Email && u.IsEmailExists(ctx, req.Email) {
+		return nil, errorConstant.ErrEmailExists
+	}
+
+	err = u.setDateOfBirth(ctx, user, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if guardianServices.IsMinor(user.DateOfBirth) && actor == user.UUID.String() {
+		return u.requestProfileChange(ctx, user, req)
 	}
 
 	newUser, err := u.repository.GetUser().Update(ctx, &dto.UpdateRequest{
@@ -180,6 +433,13 @@ func (u *UserService) Update(ctx context.Context, req *dto.UpdateRequest, uuid s
 		return nil, err
 	}
 
+	if renamed {
+		err = usernameServices.Record(ctx, u.repository, user, req.Username, actor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	response := &dto.UserResponse{
 		UUID:        newUser.UUID,
 		Name:        newUser.Name,
@@ -191,37 +451,135 @@ func (u *UserService) Update(ctx context.Context, req *dto.UpdateRequest, uuid s
 	return response, nil
 }
 
+// setDateOfBirth records a date of birth the user did not have yet. It takes
+// effect right away, linking the guardian of a minor, so that the rest of the
+// update already goes through their approval. Once set it can not be changed.
+func (u *UserService) setDateOfBirth(ctx context.Context, user *models.User, req *dto.UpdateRequest) error {
+	if req.DateOfBirth == "" {
+		return nil
+	}
+
+	dateOfBirth, err := time.ParseInLocation(time.DateOnly, req.DateOfBirth, time.Local)
+	if err != nil {
+		return err
+	}
+	if user.DateOfBirth != nil {
+		if user.DateOfBirth.Format(time.DateOnly) == req.DateOfBirth {
+			return nil
+		}
+		return errorConstant.ErrDateOfBirthSet
+	}
+
+	var guardian *models.User
+	if guardianServices.IsMinor(&dateOfBirth) {
+		guardian, err = u.findGuardian(ctx, req.GuardianEmail)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = u.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		err := tx.GetUser().UpdateDateOfBirth(ctx, user.UUID.String(), dateOfBirth)
+		if err != nil || guardian == nil {
+			return err
+		}
+
+		return tx.GetGuardian().Link(ctx, guardian.ID, user.ID, nil)
+	})
+	if err != nil {
+		return err
+	}
+	user.DateOfBirth = &dateOfBirth
+
+	return nil
+}
+
+func (u *UserService) requestProfileChange(ctx context.Context, user *models.User, req *dto.UpdateRequest) (*dto.UserResponse, error) {
+	payload, err := json.Marshal(dto.GuardianProfileChange{
+		Username:    req.Username,
+		Name:        req.Name,
+		Email:       req.Email,
+		PhoneNumber: req.PhoneNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	approval, err := u.repository.GetGuardian().FindPendingApprovalByMinor(ctx, user.ID, constants.GuardianApprovalTypeProfileUpdate)
+	switch {
+	case err == nil:
+		approval.Payload = string(payload)
+		err = u.repository.GetGuardian().UpdateApproval(ctx, approval)
+	case errors.Is(err, errorConstant.ErrApprovalNotFound):
+		approval = &models.GuardianApproval{
+			UUID:    uuid.New(),
+			MinorID: user.ID,
+			Type:    constants.GuardianApprovalTypeProfileUpdate,
+			Payload: string(payload),
+			Status:  constants.GuardianApprovalStatusPending,
+		}
+		err = u.repository.GetGuardian().CreateApproval(ctx, approval)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := toUserResponse(user)
+	response.PendingChange = guardianServices.ToGuardianApprovalResponse(approval)
+
+	return response, nil
+}
+
 func (u *UserService) UpdatePassword(ctx context.Context, req *dto.UpdatePasswordRequest, uuid string) (*dto.UserResponse, error) {
 	if req.NewPassword != req.ConfirmPassword {
 		return nil, errorConstant.ErrPasswordIsNotMatch
 	}
+
+	err := policy.Authorize(ctx, constants.ActionUserUpdatePassword, policy.Attributes{
+		"type": constants.ResourceUser,
+		"uuid": uuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := u.repository.GetUser().FindByUUID(ctx, uuid)
 	if err != nil {
 		return nil, err
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.OldPassword))
+	matched, err := password.Verify(user.Password, req.OldPassword)
 	if err != nil {
 		return nil, err
 	}
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if !matched {
+		return nil, errorConstant.ErrInvalidPassword
+	}
+	if fieldErrors := password.Validate(ctx, "NewPassword", req.NewPassword, user.Username, user.Email); fieldErrors != nil {
+		return nil, fieldErrors
+	}
+	hashedPassword, err := password.Hash(req.NewPassword)
 	if err != nil {
 		return nil, err
 	}
 
 	_, err = u.repository.GetUser().UpdatePassword(ctx, &dto.UpdatePasswordRequest{
-		NewPassword: string(hashedPassword),
+		NewPassword: hashedPassword,
 	}, uuid)
 	if err != nil {
 		return nil, err
 	}
 
+	avatarURL, avatarURLs := avatarServices.URLs(user.AvatarPath)
 	response := &dto.UserResponse{
-		UUID:        user.UUID,
-		Name:        user.Name,
-		Username:    user.Username,
-		Email:       user.Email,
-		PhoneNumber: user.PhoneNumber,
+		UUID:            user.UUID,
+		Name:            user.Name,
+		Username:        user.Username,
+		Email:           user.Email,
+		PhoneNumber:     user.PhoneNumber,
+		PhoneVerifiedAt: user.PhoneVerifiedAt,
+		AvatarURL:       avatarURL,
+		AvatarURLs:      avatarURLs,
 	}
 
 	return response, nil
@@ -233,31 +591,648 @@ func (u *UserService) GetUserLogin(ctx context.Context) (*dto.UserResponse, erro
 		data      dto.UserResponse
 	)
 
+	user, err := u.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+	avatarURL, avatarURLs := avatarServices.URLs(user.AvatarPath)
+
 	data = dto.UserResponse{
-		UUID:        userLogin.UUID,
-		Name:        userLogin.Name,
-		Username:    userLogin.Username,
-		Email:       userLogin.Email,
-		PhoneNumber: userLogin.PhoneNumber,
-		Role:        userLogin.Role,
+		UUID:            userLogin.UUID,
+		Name:            userLogin.Name,
+		Username:        userLogin.Username,
+		Email:           userLogin.Email,
+		PhoneNumber:     user.PhoneNumber,
+		PhoneVerifiedAt: user.PhoneVerifiedAt,
+		Role:            userLogin.Role,
+		AvatarURL:       avatarURL,
+		AvatarURLs:      avatarURLs,
+		DateOfBirth:     guardianServices.FormatDateOfBirth(user.DateOfBirth),
+		Minor:           guardianServices.IsMinor(user.DateOfBirth),
+	}
+
+	attributes, err := u.repository.GetAttribute().FindValues(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	data.Attributes = attributeServices.Visible(ctx, attributes, user.UUID.String())
+
+	membership, err := u.repository.GetMembership().FindCurrent(ctx, user.ID, time.Now())
+	if err != nil && !errors.Is(err, errorConstant.ErrMembershipNotFound) {
+		return nil, err
+	}
+	if membership != nil {
+		data.Membership = membershipServices.ToMembershipResponse(membership)
 	}
 
 	return &data, nil
 }
 
-func (u *UserService) GetUserByUUID(ctx context.Context, uuid string) (*dto.UserResponse, error) {
+func (u *UserService) GetUserByUUID(ctx context.Context, req *dto.UserDetailRequest, uuid string) (*dto.UserResponse, error) {
+	includes, err := parseIncludes(req.Include)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := u.repository.GetUser().FindByUUID(ctx, uuid)
 	if err != nil {
 		return nil, err
 	}
 
+	avatarURL, avatarURLs := avatarServices.URLs(user.AvatarPath)
 	response := &dto.UserResponse{
-		UUID:        user.UUID,
-		Name:        user.Name,
-		Username:    user.Username,
-		Email:       user.Email,
-		PhoneNumber: user.PhoneNumber,
+		UUID:            user.UUID,
+		Name:            user.Name,
+		Username:        user.Username,
+		Email:           user.Email,
+		PhoneNumber:     user.PhoneNumber,
+		PhoneVerifiedAt: user.PhoneVerifiedAt,
+		AvatarURL:       avatarURL,
+		AvatarURLs:      avatarURLs,
+		Minor:           guardianServices.IsMinor(user.DateOfBirth),
+	}
+	if u.dateOfBirthFilter(ctx)(user) {
+		response.DateOfBirth = guardianServices.FormatDateOfBirth(user.DateOfBirth)
+	}
+
+	if slices.Contains(includes, constants.IncludeAthleteProfile) {
+		profile, err := u.repository.GetAthleteProfile().FindByUserID(ctx, user.ID)
+		if err != nil && !errors.Is(err, errorConstant.ErrAthleteProfileNotFound) {
+			return nil, err
+		}
+		if profile != nil {
+			response.AthleteProfile = athleteProfileServices.ToAthleteProfileResponse(profile)
+		}
+	}
+
+	attributes, err := u.repository.GetAttribute().FindValues(ctx, user.ID)
+	if err != nil {
+		return nil, err
 	}
+	response.Attributes = attributeServices.Visible(ctx, attributes, user.UUID.String())
 
 	return response, nil
 }
+
+// dateOfBirthFilter decides with a single policy evaluation whose date of
+// birth the caller may see: their own, that of their minors and, for
+// administrators, everyone's. Minors are loaded once, when first needed.
+func (u *UserService) dateOfBirthFilter(ctx context.Context) func(*models.User) bool {
+	err := policy.Authorize(ctx, constants.ActionUserUpdate, policy.Attributes{
+		"type": constants.ResourceUser,
+	})
+	if err == nil {
+		return func(*models.User) bool { return true }
+	}
+
+	actor, _ := policy.SubjectFromContext(ctx)["uuid"].(string)
+	var minors map[uint]bool
+	return func(user *models.User) bool {
+		if user.UUID.String() == actor {
+			return true
+		}
+		if !guardianServices.IsMinor(user.DateOfBirth) {
+			return false
+		}
+
+		if minors == nil {
+			minors = u.guardedMinors(ctx, actor)
+		}
+		return minors[user.ID]
+	}
+}
+
+func (u *UserService) guardedMinors(ctx context.Context, uuid string) map[uint]bool {
+	minors := map[uint]bool{}
+	guardian, err := u.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return minors
+	}
+
+	users, err := u.repository.GetGuardian().FindMinors(ctx, guardian.ID)
+	if err != nil {
+		return minors
+	}
+	for i := range users {
+		minors[users[i].ID] = true
+	}
+
+	return minors
+}
+
+func parseIncludes(value string) ([]string, error) {
+	includes := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		switch item {
+		case constants.IncludeAthleteProfile:
+			includes = append(includes, item)
+		default:
+			return nil, fmt.Errorf("%w: %s", errorConstant.ErrInvalidInclude, item)
+		}
+	}
+
+	return includes, nil
+}
+
+func (u *UserService) GetUsersByUUIDs(ctx context.Context, req *dto.UserBatchRequest) (*dto.UserBatchResponse, error) {
+	maxBatchSize := config.Config.UserLookup.MaxBatchSize
+	if maxBatchSize <= 0 {
+		maxBatchSize = defaultMaxBatchSize
+	}
+
+	uuids := make([]string, 0, len(req.UUIDs))
+	seen := make(map[string]bool, len(req.UUIDs))
+	for _, value := range req.UUIDs {
+		value = strings.ToLower(value)
+		if !seen[value] {
+			seen[value] = true
+			uuids = append(uuids, value)
+		}
+	}
+
+	if len(uuids) > maxBatchSize {
+		return nil, fmt.Errorf("%w: maximum is %d", errorConstant.ErrBatchSizeExceeded, maxBatchSize)
+	}
+
+	users, err := u.repository.GetUser().FindByUUIDs(ctx, uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]*models.User, len(users))
+	for i := range users {
+		// Services only ever see active accounts; anything else is reported
+		// as not found.
+		if users[i].Status != constants.UserStatusActive {
+			continue
+		}
+		found[users[i].UUID.String()] = &users[i]
+	}
+
+	response := &dto.UserBatchResponse{
+		Users:    make([]dto.UserResponse, 0, len(found)),
+		NotFound: make([]string, 0),
+	}
+	for _, value := range uuids {
+		user, ok := found[value]
+		if !ok {
+			response.NotFound = append(response.NotFound, value)
+			continue
+		}
+		response.Users = append(response.Users, *toUserResponse(user))
+	}
+
+	return response, nil
+}
+
+func (u *UserService) ListUsers(ctx context.Context, req *dto.UserFilterRequest) (*dto.UserListResponse, error) {
+	if req.Limit == 0 {
+		req.Limit = defaultListLimit
+	}
+
+	var (
+		cursor *pagination.Cursor
+		err    error
+	)
+	if req.Cursor != "" {
+		cursor, err = pagination.DecodeCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	users, err := u.repository.GetUser().FindAll(ctx, req, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(users) > req.Limit
+	if hasMore {
+		users = users[:req.Limit]
+	}
+
+	data := make([]dto.UserResponse, 0, len(users))
+	for i := range users {
+		data = append(data, *toUserResponse(&users[i]))
+	}
+
+	response := &dto.UserListResponse{
+		Users: data,
+		Pagination: dto.PaginationResponse{
+			Limit:   req.Limit,
+			HasMore: hasMore,
+		},
+	}
+
+	if hasMore {
+		nextCursor := pagination.EncodeCursor(sortCursor(req.Sort, &users[len(users)-1]))
+		response.Pagination.NextCursor = &nextCursor
+	}
+
+	return response, nil
+}
+
+func (u *UserService) SearchUsers(ctx context.Context, req *dto.UserSearchRequest) (*dto.UserSearchResponse, error) {
+	if req.Limit == 0 {
+		req.Limit = defaultListLimit
+	}
+
+	if req.Page == 0 {
+		req.Page = 1
+	}
+
+	response := &dto.UserSearchResponse{
+		Results: []dto.UserSearchResult{},
+		Pagination: dto.PaginationResponse{
+			Limit: req.Limit,
+			Page:  req.Page,
+		},
+	}
+
+	keyword := strings.Join(strings.Fields(req.Query), " ")
+	if keyword == "" {
+		return response, nil
+	}
+
+	matches, total, err := u.repository.GetUser().Search(ctx, keyword, req.Limit, (req.Page-1)*req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	dateOfBirthVisible := u.dateOfBirthFilter(ctx)
+	for i := range matches {
+		user := toUserResponse(&matches[i].User)
+		if !dateOfBirthVisible(&matches[i].User) {
+			user.DateOfBirth = nil
+		}
+		response.Results = append(response.Results, dto.UserSearchResult{
+			User:       *user,
+			Score:      matches[i].Rank,
+			Highlights: highlight(keyword, user),
+		})
+	}
+
+	response.Pagination.Total = &total
+	response.Pagination.HasMore = int64(req.Page*req.Limit) < total
+
+	return response, nil
+}
+
+func highlight(keyword string, user *dto.UserResponse) map[string]string {
+	highlights := map[string]string{}
+	fields := map[string]string{
+		"name":         user.Name,
+		"username":     user.Username,
+		"email":        user.Email,
+		"phone_number": user.PhoneNumber,
+	}
+
+	tokens := strings.Fields(strings.ToLower(keyword))
+	for field, value := range fields {
+		marked, ok := markTokens(value, tokens)
+		if ok {
+			highlights[field] = marked
+		}
+	}
+
+	return highlights
+}
+
+func markTokens(value string, tokens []string) (string, bool) {
+	lower := strings.ToLower(value)
+	if len(lower) != len(value) {
+		return "", false
+	}
+
+	marked := make([]bool, len(value))
+	found := false
+	for _, token := range tokens {
+		for offset := 0; ; {
+			index := strings.Index(lower[offset:], token)
+			if index < 0 {
+				break
+			}
+			start := offset + index
+			for i := start; i < start+len(token); i++ {
+				marked[i] = true
+			}
+			offset = start + len(token)
+			found = true
+		}
+	}
+
+	if !found {
+		return "", false
+	}
+
+	// Values are user controlled, so every segment is escaped and only the
+	// highlight tags are emitted as markup.
+	var builder strings.Builder
+	for start := 0; start < len(value); {
+		end := start
+		for end < len(value) && marked[end] == marked[start] {
+			end++
+		}
+
+		segment := html.EscapeString(value[start:end])
+		if marked[start] {
+			segment = highlightOpen + segment + highlightClose
+		}
+		builder.WriteString(segment)
+		start = end
+	}
+
+	return builder.String(), true
+}
+
+func (u *UserService) CheckAccount(ctx context.Context, uuid string) error {
+	user, err := u.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return u.accountError(ctx, user)
+}
+
+// ResourceAttributes exposes the role, status and minor flag of a user to
+// policies that target them.
+func (u *UserService) ResourceAttributes(ctx context.Context, resourceType, uuid string) (policy.Attributes, error) {
+	if resourceType != constants.ResourceUser {
+		return nil, nil
+	}
+
+	user, err := u.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := policy.Attributes{
+		"role":   strings.ToLower(user.Role.Code),
+		"status": user.Status,
+		"minor":  guardianServices.IsMinor(user.DateOfBirth),
+	}
+
+	return attributes, nil
+}
+
+func (u *UserService) DeleteUser(ctx context.Context, uuid string) error {
+	return u.repository.GetUser().Delete(ctx, uuid)
+}
+
+func (u *UserService) RestoreUser(ctx context.Context, uuid string) (*dto.UserResponse, error) {
+	err := u.repository.GetUser().Restore(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.findUserResponse(ctx, uuid)
+}
+
+func (u *UserService) DeactivateUser(ctx context.Context, uuid string) (*dto.UserResponse, error) {
+	user, err := u.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if user.Status == constants.UserStatusSuspended {
+		return nil, errorConstant.ErrUserSuspended
+	}
+
+	err = u.repository.GetUser().UpdateStatus(ctx, uuid, constants.UserStatusDeactivated)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.findUserResponse(ctx, uuid)
+}
+
+func (u *UserService) ReactivateUser(ctx context.Context, uuid string) (*dto.UserResponse, error) {
+	user, err := u.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if user.Status == constants.UserStatusSuspended {
+		return nil, errorConstant.ErrUserSuspended
+	}
+
+	// The status alone is not enough: a suspension stays open until it is
+	// lifted, whatever the status was changed to since.
+	suspension, err := u.repository.GetSuspension().FindOpen(ctx, user.ID)
+	if err != nil && !errors.Is(err, errorConstant.ErrSuspensionNotFound) {
+		return nil, err
+	}
+	if suspension != nil && (suspension.ExpiresAt == nil || suspension.ExpiresAt.After(time.Now())) {
+		return nil, errorConstant.ErrUserSuspended
+	}
+
+	err = u.repository.GetUser().UpdateStatus(ctx, uuid, constants.UserStatusActive)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.findUserResponse(ctx, uuid)
+}
+
+func (u *UserService) DeleteAccount(ctx context.Context, req *dto.DeleteAccountRequest) (*dto.DeleteAccountResponse, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	user, err := u.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	matched, err := password.Verify(user.Password, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !matched {
+		return nil, errorConstant.ErrInvalidPassword
+	}
+
+	gracePeriod := config.Config.AccountDeletion.GracePeriodDay
+	if gracePeriod <= 0 {
+		gracePeriod = defaultDeletionGracePeriodDay
+	}
+
+	scheduledAt := time.Now().AddDate(0, 0, gracePeriod)
+	err = u.repository.GetUser().ScheduleDeletion(ctx, user.UUID.String(), scheduledAt)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.DeleteAccountResponse{
+		DeletionScheduledAt: scheduledAt,
+	}
+
+	return response, nil
+}
+
+func (u *UserService) AnonymizeDueAccounts(ctx context.Context) (int, error) {
+	batchSize := config.Config.AccountDeletion.JobBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultDeletionBatchSize
+	}
+
+	users, err := u.repository.GetUser().FindDueForDeletion(ctx, time.Now(), batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	// Each user is scrubbed in one transaction, so a user whose cleanup fails
+	// is left untouched and retried on the next run. Files are only removed
+	// once the transaction is committed.
+	for i := range users {
+		user := &users[i]
+		var exportPaths []string
+		err = u.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+			err := tx.GetAthleteProfile().DeleteByUserID(ctx, user.ID)
+			if err != nil {
+				return err
+			}
+
+			err = tx.GetEmergencyInfo().DeleteByUserID(ctx, user.ID)
+			if err != nil {
+				return err
+			}
+
+			err = tx.GetEmergencyInfo().DeleteAccessLogs(ctx, user.ID)
+			if err != nil {
+				return err
+			}
+
+			err = tx.GetPreference().DeleteByUserID(ctx, user.ID)
+			if err != nil {
+				return err
+			}
+
+			err = tx.GetAttribute().DeleteValuesByUserID(ctx, user.ID)
+			if err != nil {
+				return err
+			}
+
+			err = tx.GetConsent().ScrubAcceptances(ctx, user.ID)
+			if err != nil {
+				return err
+			}
+
+			err = tx.GetGuardian().ScrubApprovals(ctx, user.ID)
+			if err != nil {
+				return err
+			}
+
+			exportPaths, err = tx.GetDataExport().DeleteByUserID(ctx, user.ID)
+			if err != nil {
+				return err
+			}
+
+			err = usernameServices.Record(ctx, tx, user, fmt.Sprintf(constants.AnonymizedUsernameFormat, user.ID), constants.UsernameActorSystem)
+			if err != nil {
+				return err
+			}
+
+			err = tx.GetUsername().Detach(ctx, user.ID, time.Now())
+			if err != nil {
+				return err
+			}
+
+			err = tx.GetPhone().DeleteByUserID(ctx, user.ID)
+			if err != nil {
+				return err
+			}
+
+			return tx.GetUser().Anonymize(ctx, user)
+		})
+		if err != nil {
+			return i, err
+		}
+
+		avatarServices.RemoveFiles(ctx, user.AvatarPath)
+		for _, path := range exportPaths {
+			err = os.Remove(path)
+			if err != nil && !os.IsNotExist(err) {
+				logrus.Warnf("failed to remove data export %s: %v", path, err)
+			}
+		}
+	}
+
+	return len(users), nil
+}
+
+func (u *UserService) findUserResponse(ctx context.Context, uuid string) (*dto.UserResponse, error) {
+	user, err := u.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return toUserResponse(user), nil
+}
+
+// accountError is accountStatusError with the details of the user's
+// suspension, if any.
+func (u *UserService) accountError(ctx context.Context, user *models.User) error {
+	err := accountStatusError(user)
+	if errors.Is(err, errorConstant.ErrUserSuspended) {
+		return suspensionServices.Check(ctx, u.repository, user)
+	}
+
+	return err
+}
+
+func accountStatusError(user *models.User) error {
+	switch user.Status {
+	case constants.UserStatusDeactivated:
+		return errorConstant.ErrUserDeactivated
+	case constants.UserStatusSuspended:
+		return errorConstant.ErrUserSuspended
+	case constants.UserStatusPendingDeletion:
+		return errorConstant.ErrUserPendingDeletion
+	case constants.UserStatusAnonymized:
+		return errorConstant.ErrNotFound
+	case constants.UserStatusPendingApproval:
+		return errorConstant.ErrUserPendingApproval
+	}
+
+	return nil
+}
+
+func sortCursor(sort string, user *models.User) pagination.Cursor {
+	column, _ := userRepositories.SortColumn(sort)
+	cursor := pagination.Cursor{ID: user.ID}
+	switch column {
+	case "name":
+		cursor.Value = user.Name
+	case "username":
+		cursor.Value = user.Username
+	case "email":
+		cursor.Value = user.Email
+	default:
+		if user.CreatedAt != nil {
+			cursor.Value = user.CreatedAt.Format(time.RFC3339Nano)
+		}
+	}
+
+	return cursor
+}
+
+func toUserResponse(user *models.User) *dto.UserResponse {
+	avatarURL, avatarURLs := avatarServices.URLs(user.AvatarPath)
+
+	return &dto.UserResponse{
+		UUID:            user.UUID,
+		Name:            user.Name,
+		Username:        user.Username,
+		Email:           user.Email,
+		PhoneNumber:     user.PhoneNumber,
+		PhoneVerifiedAt: user.PhoneVerifiedAt,
+		Role:            strings.ToLower(user.Role.Code),
+		Status:          user.Status,
+		AvatarURL:       avatarURL,
+		AvatarURLs:      avatarURLs,
+		DateOfBirth:     guardianServices.FormatDateOfBirth(user.DateOfBirth),
+		Minor:           guardianServices.IsMinor(user.DateOfBirth),
+		CreatedAt:       user.CreatedAt,
+	}
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/repositories"
+	userRepositories "user-service/repositories/user"
+)
+
+const flushEvery = 500
+
+type UserExportService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IUserExportService interface {
+	Export(context.Context, io.Writer, *dto.UserExportRequest) error
+}
+
+func NewUserExportService(repository repositories.IRepositoryRegistry) IUserExportService {
+	return &UserExportService{
+		repository: repository,
+	}
+}
+
+func (u *UserExportService) Export(ctx context.Context, writer io.Writer, req *dto.UserExportRequest) error {
+	columns, err := ParseColumns(req.Columns)
+	if err != nil {
+		return err
+	}
+
+	var (
+		count    int
+		flush    func() error
+		writeRow func(map[string]string) error
+	)
+
+	switch req.Format {
+	case constants.ImportFormatNDJSON:
+		encoder := json.NewEncoder(writer)
+		writeRow = func(values map[string]string) error {
+			return encoder.Encode(values)
+		}
+		flush = func() error {
+			return nil
+		}
+	default:
+		csvWriter := csv.NewWriter(writer)
+		err = csvWriter.Write(columns)
+		if err != nil {
+			return err
+		}
+		writeRow = func(values map[string]string) error {
+			record := make([]string, 0, len(columns))
+			for _, column := range columns {
+				record = append(record, values[column])
+			}
+			return csvWriter.Write(record)
+		}
+		flush = func() error {
+			csvWriter.Flush()
+			return csvWriter.Error()
+		}
+	}
+
+	err = u.repository.GetUser().Stream(ctx, &req.UserFilterRequest, func(row *userRepositories.UserRow) error {
+		values := rowValues(row, columns, req.MaskPII)
+		err := writeRow(values)
+		if err != nil {
+			return err
+		}
+
+		count++
+		if count%flushEvery == 0 {
+			err = flush()
+			if err != nil {
+				return err
+			}
+			if flusher, ok := writer.(http.Flusher); ok {
+				flusher.Flush()
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return flush()
+}
+
+func ParseColumns(value string) ([]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return constants.ExportColumns, nil
+	}
+
+	columns := make([]string, 0)
+	for _, column := range strings.Split(value, ",") {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !slices.Contains(constants.ExportColumns, column) {
+			return nil, fmt.Errorf("%w: %s", errConstant.ErrInvalidExportColumn, column)
+		}
+		if !slices.Contains(columns, column) {
+			columns = append(columns, column)
+		}
+	}
+
+	return columns, nil
+}
+
+func rowValues(row *userRepositories.UserRow, columns []string, maskPII bool) map[string]string {
+	values := make(map[string]string, len(columns))
+	for _, column := range columns {
+		switch column {
+		case "uuid":
+			values[column] = row.UUID.String()
+		case "name":
+			values[column] = maskIf(maskPII, row.Name, maskName)
+		case "username":
+			values[column] = row.Username
+		case "email":
+			values[column] = maskIf(maskPII, row.Email, maskEmail)
+		case "phone_number":
+			values[column] = maskIf(maskPII, row.PhoneNumber, maskPhoneNumber)
+		case "date_of_birth":
+			if row.DateOfBirth != nil && !maskPII {
+				values[column] = row.DateOfBirth.Format(time.DateOnly)
+			}
+		case "role":
+			values[column] = strings.ToLower(row.RoleCode)
+		case "status":
+			values[column] = row.Status
+		case "created_at":
+			values[column] = formatTime(row.CreatedAt)
+		case "updated_at":
+			values[column] = formatTime(row.UpdatedAt)
+		}
+	}
+
+	return values
+}
+
+func maskIf(mask bool, value string, masker func(string) string) string {
+	if !mask || value == "" {
+		return value
+	}
+
+	return masker(value)
+}
+
+func maskName(name string) string {
+	words := strings.Fields(name)
+	for i, word := range words {
+		runes := []rune(word)
+		words[i] = string(runes[0]) + strings.Repeat("*", len(runes)-1)
+	}
+
+	return strings.Join(words, " ")
+}
+
+func maskEmail(email string) string {
+	local, domain, found := strings.Cut(email, "@")
+	if !found {
+		return maskName(email)
+	}
+
+	runes := []rune(local)
+	return string(runes[0]) + strings.Repeat("*", len(runes)-1) + "@" + domain
+}
+
+func maskPhoneNumber(phoneNumber string) string {
+	visible := 3
+	if len(phoneNumber) <= visible {
+		return strings.Repeat("*", len(phoneNumber))
+	}
+
+	return strings.Repeat("*", len(phoneNumber)-visible) + phoneNumber[len(phoneNumber)-visible:]
+}
+
+func formatTime(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.Format(time.RFC3339)
+}
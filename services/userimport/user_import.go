@@ -0,0 +1,303 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+	errWrap "user-service/common/error"
+	"user-service/common/password"
+	"user-service/common/phone"
+	"user-service/config"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+	guardianServices "user-service/services/guardian"
+	usernameServices "user-service/services/username"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+const defaultBatchSize = 100
+
+var csvColumns = map[string]string{
+	"username":         "username",
+	"usernmae":         "username",
+	"name":             "name",
+	"email":            "email",
+	"phone_number":     "phone_number",
+	"password":         "password",
+	"confirm_password": "confirm_password",
+	"date_of_birth":    "date_of_birth",
+}
+
+type UserImportService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IUserImportService interface {
+	Import(context.Context, io.Reader, *dto.UserImportRequest) (*dto.UserImportResponse, error)
+}
+
+func NewUserImportService(repository repositories.IRepositoryRegistry) IUserImportService {
+	return &UserImportService{
+		repository: repository,
+	}
+}
+
+func (u *UserImportService) Import(ctx context.Context, reader io.Reader, req *dto.UserImportRequest) (*dto.UserImportResponse, error) {
+	var (
+		rows []dto.RegiterRequest
+		err  error
+	)
+
+	switch req.Format {
+	case constants.ImportFormatCSV, "":
+		rows, err = parseCSV(reader)
+	case constants.ImportFormatNDJSON:
+		rows, err = parseNDJSON(reader)
+	default:
+		return nil, errConstant.ErrInvalidImportFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.UserImportResponse{
+		DryRun: req.DryRun,
+		Total:  len(rows),
+		Errors: []dto.UserImportRowError{},
+	}
+
+	var (
+		validate  = validator.New()
+		usernames = map[string]bool{}
+		emails    = map[string]bool{}
+		users     = make([]models.User, 0, len(rows))
+	)
+	for i := range rows {
+		row := &rows[i]
+		rowErrors := u.validateRow(ctx, validate, row, usernames, emails)
+		if len(rowErrors) > 0 {
+			response.Errors = append(response.Errors, dto.UserImportRowError{
+				Row:    i + 1,
+				Errors: rowErrors,
+			})
+			continue
+		}
+
+		if req.DryRun {
+			continue
+		}
+
+		hashedPassword, err := password.Hash(row.Password)
+		if err != nil {
+			return nil, err
+		}
+
+		var dateOfBirth *time.Time
+		if row.DateOfBirth != "" {
+			value, _ := time.ParseInLocation(time.DateOnly, row.DateOfBirth, time.Local)
+			dateOfBirth = &value
+		}
+
+		users = append(users, models.User{
+			UUID:        uuid.New(),
+			Name:        row.Name,
+			Username:    row.Username,
+			Email:       row.Email,
+			Password:    hashedPassword,
+			PhoneNumber: row.PhoneNumber,
+			DateOfBirth: dateOfBirth,
+			RoleId:      constants.Customer,
+			Status:      constants.UserStatusActive,
+		})
+	}
+
+	response.Invalid = len(response.Errors)
+	response.Valid = response.Total - response.Invalid
+	if req.DryRun || response.Invalid > 0 || len(users) == 0 {
+		return response, nil
+	}
+
+	batchSize := config.Config.UserImport.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	err = u.repository.GetUser().BulkCreate(ctx, users, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	response.Imported = len(users)
+
+	return response, nil
+}
+
+func (u *UserImportService) validateRow(
+	ctx context.Context,
+	validate *validator.Validate,
+	row *dto.RegiterRequest,
+	usernames, emails map[string]bool,
+) []errWrap.ValidationResponse {
+	if row.ConfirmPassword == "" {
+		row.ConfirmPassword = row.Password
+	}
+	row.PhoneNumber = phone.Normalize(row.PhoneNumber)
+
+	err := validate.Struct(row)
+	if err != nil {
+		return errWrap.ErrValidationResponse(err)
+	}
+
+	var rowErrors []errWrap.ValidationResponse
+	if row.DateOfBirth != "" {
+		dateOfBirth, err := time.ParseInLocation(time.DateOnly, row.DateOfBirth, time.Local)
+		if err == nil && guardianServices.IsMinor(&dateOfBirth) {
+			rowErrors = append(rowErrors, errWrap.ValidationResponse{
+				Field:   "DateOfBirth",
+				Message: errConstant.ErrMinorImportNotAllowed.Error(),
+			})
+		}
+	}
+
+	if row.Password != row.ConfirmPassword {
+		rowErrors = append(rowErrors, errWrap.ValidationResponse{
+			Field:   "ConfirmPassword",
+			Message: errConstant.ErrPasswordIsNotMatch.Error(),
+		})
+	}
+	if fieldErrors := password.Validate(ctx, "Password", row.Password, row.Username, row.Email); fieldErrors != nil {
+		rowErrors = append(rowErrors, errWrap.ErrValidationResponse(fieldErrors)...)
+	}
+
+	username := strings.ToLower(row.Username)
+	if usernames[username] {
+		rowErrors = append(rowErrors, errWrap.ValidationResponse{
+			Field:   "Username",
+			Message: "Username is duplicated in the file",
+		})
+	} else if exists, err := u.repository.GetUser().ExistsByUsername(ctx, row.Username); exists || err != nil {
+		rowErrors = append(rowErrors, errWrap.ValidationResponse{
+			Field:   "Username",
+			Message: errConstant.ErrUsernameExists.Error(),
+		})
+	} else if err := usernameServices.CheckAvailable(ctx, u.repository, row.Username); err != nil {
+		rowErrors = append(rowErrors, errWrap.ValidationResponse{
+			Field:   "Username",
+			Message: err.Error(),
+		})
+	}
+	usernames[username] = true
+
+	email := strings.ToLower(row.Email)
+	if emails[email] {
+		rowErrors = append(rowErrors, errWrap.ValidationResponse{
+			Field:   "Email",
+			Message: "Email is duplicated in the file",
+		})
+	} else if exists, err := u.repository.GetUser().ExistsByEmail(ctx, row.Email); exists || err != nil {
+		rowErrors = append(rowErrors, errWrap.ValidationResponse{
+			Field:   "Email",
+			Message: errConstant.ErrEmailExists.Error(),
+		})
+	}
+	emails[email] = true
+
+	return rowErrors
+}
+
+func parseCSV(reader io.Reader) ([]dto.RegiterRequest, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errConstant.ErrInvalidImportFormat, err)
+	}
+
+	columns := make([]string, len(header))
+	for i, name := range header {
+		columns[i] = csvColumns[strings.ToLower(strings.TrimSpace(name))]
+	}
+
+	var rows []dto.RegiterRequest
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errConstant.ErrInvalidImportFormat, err)
+		}
+
+		var row dto.RegiterRequest
+		for i, value := range record {
+			switch columns[i] {
+			case "username":
+				row.Username = value
+			case "name":
+				row.Name = value
+			case "email":
+				row.Email = value
+			case "phone_number":
+				row.PhoneNumber = value
+			case "password":
+				row.Password = value
+			case "confirm_password":
+				row.ConfirmPassword = value
+			case "date_of_birth":
+				row.DateOfBirth = value
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func parseNDJSON(reader io.Reader) ([]dto.RegiterRequest, error) {
+	var rows []dto.RegiterRequest
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		content := strings.TrimSpace(scanner.Text())
+		if content == "" {
+			continue
+		}
+
+		var row dto.RegiterRequest
+		err := json.Unmarshal([]byte(content), &row)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", errConstant.ErrInvalidImportFormat, line, err)
+		}
+		rows = append(rows, row)
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errConstant.ErrInvalidImportFormat, err)
+	}
+
+	return rows, nil
+}
+
+func FormatFromFilename(filename string) string {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".ndjson", ".jsonl":
+		return constants.ImportFormatNDJSON
+	default:
+		return constants.ImportFormatCSV
+	}
+}
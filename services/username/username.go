@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"user-service/config"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+)
+
+const (
+	defaultRenameCooldownDay = 30
+	defaultHoldPeriodDay     = 90
+)
+
+type UsernameService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IUsernameService interface {
+	History(context.Context, string) ([]dto.UsernameHistoryResponse, error)
+}
+
+func NewUsernameService(repository repositories.IRepositoryRegistry) IUsernameService {
+	return &UsernameService{
+		repository: repository,
+	}
+}
+
+func (u *UsernameService) History(ctx context.Context, uuid string) ([]dto.UsernameHistoryResponse, error) {
+	user, err := u.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	histories, err := u.repository.GetUsername().FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.UsernameHistoryResponse, 0, len(histories))
+	for _, history := range histories {
+		response = append(response, dto.UsernameHistoryResponse{
+			Username:    history.Username,
+			NewUsername: history.NewUsername,
+			ChangedBy:   history.ChangedBy,
+			HeldUntil:   history.HeldUntil,
+			ChangedAt:   history.CreatedAt,
+		})
+	}
+
+	return response, nil
+}
+
+// Validate rejects reserved and profane usernames.
+func Validate(username string) error {
+	normalized := normalize(username)
+
+	reserved := append(slices.Clone(constants.ReservedUsernames), config.Config.Username.Reserved...)
+	for _, word := range reserved {
+		if normalize(word) == normalized {
+			return errConstant.ErrUsernameReserved
+		}
+	}
+
+	blocked := append(slices.Clone(constants.BlockedUsernameWords), config.Config.Username.Blocked...)
+	for _, word := range blocked {
+		word = normalize(word)
+		if word != "" && strings.Contains(normalized, word) {
+			return errConstant.ErrUsernameNotAllowed
+		}
+	}
+
+	return nil
+}
+
+// CheckRename validates a rename of user to username. The cooldown only
+// applies when users rename themselves; the hold period always applies.
+func CheckRename(ctx context.Context, repository repositories.IRepositoryRegistry, user *models.User, username string, self bool) error {
+	err := Validate(username)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	held, err := repository.GetUsername().IsHeld(ctx, username, user.ID, now)
+	if err != nil {
+		return err
+	}
+	if held {
+		return errConstant.ErrUsernameOnHold
+	}
+
+	if !self {
+		return nil
+	}
+
+	histories, err := repository.GetUsername().FindByUserID(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if len(histories) > 0 {
+		next := histories[0].CreatedAt.AddDate(0, 0, renameCooldownDay())
+		if next.After(now) {
+			return fmt.Errorf("%w, try again after %s", errConstant.ErrUsernameCooldown, next.Format(time.DateOnly))
+		}
+	}
+
+	return nil
+}
+
+// CheckAvailable rejects usernames that are blocked or still on hold for a new
+// account.
+func CheckAvailable(ctx context.Context, repository repositories.IRepositoryRegistry, username string) error {
+	err := Validate(username)
+	if err != nil {
+		return err
+	}
+
+	held, err := repository.GetUsername().IsHeld(ctx, username, 0, time.Now())
+	if err != nil {
+		return err
+	}
+	if held {
+		return errConstant.ErrUsernameOnHold
+	}
+
+	return nil
+}
+
+// Record stores the previous username of user and holds it for the configured
+// period.
+func Record(ctx context.Context, repository repositories.IRepositoryRegistry, user *models.User, newUsername, actor string) error {
+	now := time.Now()
+	return repository.GetUsername().Create(ctx, &models.UsernameHistory{
+		UserID:      &user.ID,
+		Username:    user.Username,
+		NewUsername: newUsername,
+		ChangedBy:   actor,
+		HeldUntil:   now.AddDate(0, 0, holdPeriodDay()),
+		CreatedAt:   now,
+	})
+}
+
+func normalize(username string) string {
+	var builder strings.Builder
+	for _, char := range strings.ToLower(username) {
+		if replacement, ok := constants.LeetReplacer[char]; ok {
+			char = replacement
+		}
+		if unicode.IsLetter(char) {
+			builder.WriteRune(char)
+		}
+	}
+
+	return builder.String()
+}
+
+func renameCooldownDay() int {
+	cooldown := config.Config.Username.RenameCooldownDay
+	if cooldown <= 0 {
+		cooldown = defaultRenameCooldownDay
+	}
+
+	return cooldown
+}
+
+func holdPeriodDay() int {
+	hold := config.Config.Username.HoldPeriodDay
+	if hold <= 0 {
+		hold = defaultHoldPeriodDay
+	}
+
+	return hold
+}